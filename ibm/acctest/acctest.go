@@ -285,6 +285,7 @@ var (
 	Pi_ssh_key_id                     string
 	Pi_storage_connection             string
 	Pi_target_storage_tier            string
+	Pi_target_workspace_crn           string
 	Pi_virtual_serial_number          string
 	Pi_volume_clone_task_id           string
 	Pi_volume_group_id                string
@@ -1522,6 +1523,12 @@ func init() {
 		fmt.Println("[INFO] Set the environment variable PI_TARGET_STORAGE_TIER for testing Pi_target_storage_tier resource else it is set to default value 'terraform-test-tier'")
 	}
 
+	Pi_target_workspace_crn = os.Getenv("PI_TARGET_WORKSPACE_CRN")
+	if Pi_target_workspace_crn == "" {
+		Pi_target_workspace_crn = "crn:v1:bluemix:public:power-iaas:dal10:a/terraform-test-account:terraform-test-power::"
+		fmt.Println("[INFO] Set the environment variable PI_TARGET_WORKSPACE_CRN for testing ibm_pi_image_replica resource else it is set to default value 'crn:v1:bluemix:public:power-iaas:dal10:a/terraform-test-account:terraform-test-power::'")
+	}
+
 	Pi_volume_clone_task_id = os.Getenv("PI_VOLUME_CLONE_TASK_ID")
 	if Pi_volume_clone_task_id == "" {
 		Pi_volume_clone_task_id = "terraform-test-volume-clone-task-id"
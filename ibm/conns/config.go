@@ -275,6 +275,8 @@ type ClientSession interface {
 	LogsRouterV3() (*logsrouterv3.LogsRouterV3, error)
 	SoftLayerSession() *slsession.Session
 	IBMPISession() (*ibmpisession.IBMPISession, error)
+	IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error)
+	ForZone(zone string) ClientSession
 	UserManagementAPI() (usermanagementv2.UserManagementAPI, error)
 	PushServiceV1() (*pushservicev1.PushServiceV1, error)
 	EventNotificationsApiV1() (*eventnotificationsv1.EventNotificationsV1, error)
@@ -283,6 +285,8 @@ type ClientSession interface {
 	KeyManagementAPI() (*kp.Client, error)
 	KeyProtectCryptoUnitAPI(context.Context, *kpCryptoUnit.KeyProtectCryptoUnitAPIOptions) (*kpCryptoUnit.KeyProtectCryptoUnitAPI, error)
 	VpcV1API() (*vpc.VpcV1, error)
+	VpcV1APIForRegion(region string) (*vpc.VpcV1, error)
+	ForRegion(region string) ClientSession
 	VpcV1BetaAPI() (*vpcbeta.VpcbetaV1, error)
 	PrivateDNSClientSession() (*dns.DnsSvcsV1, error)
 	CosConfigV1API() (*cosconfig.ResourceConfigurationV1, error)
@@ -365,6 +369,9 @@ type clientSession struct {
 	authenticator    core.Authenticator
 	authenticatorErr error
 
+	// Clients for regions and zones selected by individual resources
+	regional *regionalClients
+
 	appidErr error
 	appidAPI *appid.AppIDManagementV4
 
@@ -2382,6 +2389,29 @@ func (c *Config) ClientSession() (*clientSession, error) {
 	}
 	session.vpcAPI = vpcclient
 
+	session.regional = newRegionalClients(c.Region, c.Zone)
+	session.regional.newVpcAPI = func(region string) (*vpc.VpcV1, error) {
+		regionURL := ContructEndpoint(fmt.Sprintf("%s.iaas", region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		if c.Visibility == "private" || c.Visibility == "public-and-private" {
+			regionURL = ContructEndpoint(fmt.Sprintf("%s.private.iaas", region), fmt.Sprintf("%s/v1", cloudEndpoint))
+		}
+		if fileMap != nil && c.Visibility != "public-and-private" {
+			regionURL = fileFallBack(fileMap, c.Visibility, "IBMCLOUD_IS_NG_API_ENDPOINT", region, regionURL)
+		}
+		client, err := vpc.NewVpcV1(&vpc.VpcV1Options{
+			URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, regionURL),
+			Authenticator: authenticator,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error occured while configuring vpc service for region %s: %q", region, err)
+		}
+		client.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		client.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+		return client, nil
+	}
+
 	vpcbetaoptions := &vpcbeta.VpcbetaV1Options{
 		URL:           EnvFallBack([]string{"IBMCLOUD_IS_NG_API_ENDPOINT"}, vpcurl),
 		Authenticator: authenticator,
@@ -2660,6 +2690,21 @@ func (c *Config) ClientSession() (*clientSession, error) {
 	session.functionIAMNamespaceAPI = namespaceFunction
 
 	// POWER SYSTEMS Service
+	session.regional.newIBMPISession = func(zone string) (*ibmpisession.IBMPISession, error) {
+		// The region, and with it the endpoint, is derived from the zone
+		piSession, err := ibmpisession.NewIBMPISession(&ibmpisession.IBMPIOptions{
+			Authenticator: authenticator,
+			Debug:         os.Getenv("TF_LOG") != "",
+			URL:           EnvFallBack([]string{"IBMCLOUD_PI_API_ENDPOINT"}, "power-iaas.cloud.ibm.com"),
+			UserAccount:   userConfig.UserAccount,
+			Zone:          zone,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error occured while configuring ibmpisession for zone %s: %q", zone, err)
+		}
+		return piSession, nil
+	}
+
 	piURL := ContructEndpoint(c.Region, "power-iaas.cloud.ibm.com")
 	ibmPIOptions := &ibmpisession.IBMPIOptions{
 		Authenticator: authenticator,
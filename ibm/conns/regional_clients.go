@@ -0,0 +1,136 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package conns
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+// regionalClients holds the clients that are built for a region or zone other
+// than the one configured on the provider. Clients are created the first time a
+// resource asks for them and are reused for the lifetime of the provider.
+//
+// clientSession is passed around by value, so it keeps a pointer to a single
+// regionalClients that is shared by every copy.
+//
+// ibm_is_vpc, ibm_is_subnet, ibm_pi_network and ibm_pi_volume, and their data
+// sources, select a client through it themselves. The other VPC and Power
+// Systems resources get a session from ForRegion and ForZone instead.
+type regionalClients struct {
+	region string
+	zone   string
+
+	lock          sync.Mutex
+	vpcAPIs       map[string]*vpcv1.VpcV1
+	ibmpiSessions map[string]*ibmpisession.IBMPISession
+
+	newVpcAPI       func(region string) (*vpcv1.VpcV1, error)
+	newIBMPISession func(zone string) (*ibmpisession.IBMPISession, error)
+}
+
+func newRegionalClients(region, zone string) *regionalClients {
+	return &regionalClients{
+		region:        region,
+		zone:          zone,
+		vpcAPIs:       make(map[string]*vpcv1.VpcV1),
+		ibmpiSessions: make(map[string]*ibmpisession.IBMPISession),
+	}
+}
+
+// vpcAPI returns the cached VPC client for region, building it on first use.
+// Failed builds are not cached so that a later call can retry.
+func (r *regionalClients) vpcAPI(region string) (*vpcv1.VpcV1, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if client, ok := r.vpcAPIs[region]; ok {
+		return client, nil
+	}
+	if r.newVpcAPI == nil {
+		return nil, fmt.Errorf("[ERROR] VPC client for region %q is not configured", region)
+	}
+	log.Printf("[INFO] Configuring VPC client for region %s", region)
+	client, err := r.newVpcAPI(region)
+	if err != nil {
+		return nil, err
+	}
+	r.vpcAPIs[region] = client
+	return client, nil
+}
+
+// ibmpiSession returns the cached Power Systems session for zone, building it
+// on first use. Failed builds are not cached so that a later call can retry.
+func (r *regionalClients) ibmpiSession(zone string) (*ibmpisession.IBMPISession, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if session, ok := r.ibmpiSessions[zone]; ok {
+		return session, nil
+	}
+	if r.newIBMPISession == nil {
+		return nil, fmt.Errorf("[ERROR] Power Systems session for zone %q is not configured", zone)
+	}
+	log.Printf("[INFO] Configuring Power Systems session for zone %s", zone)
+	session, err := r.newIBMPISession(zone)
+	if err != nil {
+		return nil, err
+	}
+	r.ibmpiSessions[zone] = session
+	return session, nil
+}
+
+// VpcV1APIForRegion returns a VPC client for region. An empty region, or the
+// region configured on the provider, returns the default client.
+func (sess clientSession) VpcV1APIForRegion(region string) (*vpcv1.VpcV1, error) {
+	if sess.regional == nil || region == "" || region == sess.regional.region {
+		return sess.VpcV1API()
+	}
+	if sess.vpcErr == errEmptyBluemixCredentials {
+		return nil, sess.vpcErr
+	}
+	return sess.regional.vpcAPI(region)
+}
+
+// IBMPISessionForZone returns a Power Systems session for zone. An empty zone,
+// or the zone configured on the provider, returns the default session.
+func (sess clientSession) IBMPISessionForZone(zone string) (*ibmpisession.IBMPISession, error) {
+	if sess.regional == nil || zone == "" || zone == sess.regional.zone {
+		return sess.IBMPISession()
+	}
+	// The default session fails to build when the provider has no zone, which
+	// must not prevent a resource from selecting its own.
+	if sess.ibmpiConfigErr == errEmptyBluemixCredentials {
+		return nil, sess.ibmpiConfigErr
+	}
+	return sess.regional.ibmpiSession(zone)
+}
+
+// ForRegion returns a copy of the session whose VPC client is the one for
+// region, so that code calling VpcV1API manages resources in that region. An
+// empty region, or the region configured on the provider, returns the session
+// unchanged. An error building the client is returned by VpcV1API.
+func (sess *clientSession) ForRegion(region string) ClientSession {
+	if sess.regional == nil || region == "" || region == sess.regional.region {
+		return sess
+	}
+	regionalSess := *sess
+	regionalSess.vpcAPI, regionalSess.vpcErr = sess.VpcV1APIForRegion(region)
+	return &regionalSess
+}
+
+// ForZone returns a copy of the session whose Power Systems session is the one
+// for zone. An empty zone, or the zone configured on the provider, returns the
+// session unchanged. An error building the session is returned by
+// IBMPISession.
+func (sess *clientSession) ForZone(zone string) ClientSession {
+	if sess.regional == nil || zone == "" || zone == sess.regional.zone {
+		return sess
+	}
+	zonalSess := *sess
+	zonalSess.ibmpiSession, zonalSess.ibmpiConfigErr = sess.IBMPISessionForZone(zone)
+	return &zonalSess
+}
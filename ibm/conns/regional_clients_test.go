@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+package conns
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+func TestRegionalClientsVpcAPICached(t *testing.T) {
+	defaultClient := &vpcv1.VpcV1{}
+	sess := clientSession{
+		vpcAPI:   defaultClient,
+		regional: newRegionalClients("us-south", "dal10"),
+	}
+	builds := 0
+	sess.regional.newVpcAPI = func(region string) (*vpcv1.VpcV1, error) {
+		builds++
+		return &vpcv1.VpcV1{}, nil
+	}
+
+	for _, region := range []string{"", "us-south"} {
+		client, err := sess.VpcV1APIForRegion(region)
+		if err != nil || client != defaultClient {
+			t.Fatalf("region %q: expected the default client, got %v, %v", region, client, err)
+		}
+	}
+
+	first, err := sess.VpcV1APIForRegion("eu-de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := sess.VpcV1APIForRegion("eu-de")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second || first == defaultClient {
+		t.Fatal("expected a single cached client for eu-de")
+	}
+	if _, err := sess.VpcV1APIForRegion("jp-tok"); err != nil {
+		t.Fatal(err)
+	}
+	if builds != 2 {
+		t.Fatalf("expected 2 clients to be built, got %d", builds)
+	}
+}
+
+func TestRegionalClientsIBMPISessionRetry(t *testing.T) {
+	sess := clientSession{
+		ibmpiConfigErr: errors.New("option Zone is required"),
+		regional:       newRegionalClients("us-south", ""),
+	}
+	fail := true
+	sess.regional.newIBMPISession = func(zone string) (*ibmpisession.IBMPISession, error) {
+		if fail {
+			return nil, errors.New("unavailable")
+		}
+		return &ibmpisession.IBMPISession{}, nil
+	}
+
+	if _, err := sess.IBMPISessionForZone(""); err == nil {
+		t.Fatal("expected the default session error")
+	}
+	if _, err := sess.IBMPISessionForZone("dal10"); err == nil {
+		t.Fatal("expected the build error")
+	}
+	fail = false
+	if session, err := sess.IBMPISessionForZone("dal10"); err != nil || session == nil {
+		t.Fatalf("expected the session to be built on retry, got %v, %v", session, err)
+	}
+}
+
+func TestClientSessionForRegion(t *testing.T) {
+	defaultClient := &vpcv1.VpcV1{}
+	regionalClient := &vpcv1.VpcV1{}
+	sess := clientSession{
+		vpcAPI:   defaultClient,
+		regional: newRegionalClients("us-south", "dal10"),
+	}
+	sess.regional.newVpcAPI = func(region string) (*vpcv1.VpcV1, error) {
+		if region != "eu-de" {
+			return nil, errors.New("unknown region")
+		}
+		return regionalClient, nil
+	}
+
+	for _, region := range []string{"", "us-south"} {
+		if client, err := sess.ForRegion(region).VpcV1API(); err != nil || client != defaultClient {
+			t.Fatalf("region %q: expected the default client, got %v, %v", region, client, err)
+		}
+	}
+	if client, err := sess.ForRegion("eu-de").VpcV1API(); err != nil || client != regionalClient {
+		t.Fatalf("expected the eu-de client, got %v, %v", client, err)
+	}
+	if _, err := sess.ForRegion("jp-tok").VpcV1API(); err == nil {
+		t.Fatal("expected the build error")
+	}
+	if client, _ := sess.VpcV1API(); client != defaultClient {
+		t.Fatal("expected the original session to keep the default client")
+	}
+}
+
+func TestClientSessionForZone(t *testing.T) {
+	defaultSession := &ibmpisession.IBMPISession{}
+	zonalSession := &ibmpisession.IBMPISession{}
+	sess := clientSession{
+		ibmpiSession: defaultSession,
+		regional:     newRegionalClients("us-south", "dal10"),
+	}
+	sess.regional.newIBMPISession = func(zone string) (*ibmpisession.IBMPISession, error) {
+		return zonalSession, nil
+	}
+
+	if session, err := sess.ForZone("dal10").IBMPISession(); err != nil || session != defaultSession {
+		t.Fatalf("expected the default session, got %v, %v", session, err)
+	}
+	if session, err := sess.ForZone("mad02").IBMPISession(); err != nil || session != zonalSession {
+		t.Fatalf("expected the mad02 session, got %v, %v", session, err)
+	}
+}
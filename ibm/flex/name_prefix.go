@@ -0,0 +1,55 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package flex
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// NamePrefix is the conventional argument name for a name prefix.
+	NamePrefix = "name_prefix"
+
+	// NamePrefixSuffixLength is the length of the unique suffix appended to a
+	// name prefix. Validators use it to bound the length of the prefix.
+	NamePrefixSuffixLength = id.UniqueIDSuffixLength
+)
+
+// The suffix generated by id.PrefixedUniqueId: a 18 digit timestamp followed by
+// an 8 digit hexadecimal counter.
+var namePrefixSuffixRegexp = regexp.MustCompile(`^(.+)[0-9]{18}[0-9a-f]{8}$`)
+
+// PrefixedUniqueName returns a unique name that begins with prefix. The suffix
+// only contains lowercase hexadecimal characters, so a prefix that is valid for
+// validate.ValidateISNamePrefix always produces a name that is valid for
+// validate.ValidateISName.
+func PrefixedUniqueName(prefix string) string {
+	return id.PrefixedUniqueId(prefix)
+}
+
+// ResolveName returns the name to create a resource with. An explicit name
+// wins, otherwise a unique name is generated from the prefix. An empty string
+// is returned when neither is set so the service can choose a name.
+func ResolveName(d *schema.ResourceData, nameKey, prefixKey string) string {
+	if name, ok := d.GetOk(nameKey); ok {
+		return name.(string)
+	}
+	if prefix, ok := d.GetOk(prefixKey); ok {
+		return PrefixedUniqueName(prefix.(string))
+	}
+	return ""
+}
+
+// NamePrefixFromName returns the prefix a name was generated from by
+// PrefixedUniqueName, or an empty string if the name was not generated. It is
+// used on read so that imported resources keep their name_prefix.
+func NamePrefixFromName(name string) string {
+	if match := namePrefixSuffixRegexp.FindStringSubmatch(name); match != nil {
+		return match[1]
+	}
+	return ""
+}
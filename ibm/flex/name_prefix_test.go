@@ -0,0 +1,40 @@
+package flex
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixedUniqueName(t *testing.T) {
+	first := PrefixedUniqueName("example-sg-")
+	second := PrefixedUniqueName("example-sg-")
+
+	assert.True(t, strings.HasPrefix(first, "example-sg-"))
+	assert.Equal(t, len("example-sg-")+NamePrefixSuffixLength, len(first))
+	assert.NotEqual(t, first, second)
+}
+
+func TestNamePrefixFromName(t *testing.T) {
+	assert.Equal(t, "example-sg-", NamePrefixFromName(PrefixedUniqueName("example-sg-")))
+	assert.Equal(t, "", NamePrefixFromName("example-sg"))
+	assert.Equal(t, "", NamePrefixFromName("20260101000000000000000001"))
+}
+
+func TestResolveName(t *testing.T) {
+	resourceSchema := map[string]*schema.Schema{
+		"name":     {Type: schema.TypeString, Optional: true},
+		NamePrefix: {Type: schema.TypeString, Optional: true},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"name": "example"})
+	assert.Equal(t, "example", ResolveName(d, "name", NamePrefix))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{NamePrefix: "example-"})
+	assert.Equal(t, "example-", NamePrefixFromName(ResolveName(d, "name", NamePrefix)))
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+	assert.Equal(t, "", ResolveName(d, "name", NamePrefix))
+}
@@ -409,6 +409,7 @@ func Provider() *schema.Provider {
 			"ibm_cloud_shell_account_settings":              cloudshell.DataSourceIBMCloudShellAccountSettings(),
 			"ibm_cos_bucket":                                cos.DataSourceIBMCosBucket(),
 			"ibm_cos_backup_vault":                          cos.DataSourceIBMCosBackupVault(),
+			"ibm_cos_backup_vault_recovery_ranges":          cos.DataSourceIBMCosBackupVaultRecoveryRanges(),
 			"ibm_cos_backup_policy":                         cos.DataSourceIBMCosBackupPolicy(),
 			"ibm_cos_bucket_object":                         cos.DataSourceIBMCosBucketObject(),
 			"ibm_dns_domain_registration":                   classicinfrastructure.DataSourceIBMDNSDomainRegistration(),
@@ -667,6 +668,8 @@ func Provider() *schema.Provider {
 			"ibm_is_vpn_gateway_service_connection":     vpc.DataSourceIBMIsVPNGatewayServiceConnection(),
 			"ibm_is_vpn_gateway_service_connections":    vpc.DataSourceIBMIsVPNGatewayServiceConnections(),
 
+			"ibm_is_vpn_gateway_connection_peer_configuration": vpc.DataSourceIBMIsVPNGatewayConnectionPeerConfiguration(),
+
 			"ibm_is_vpc_default_routing_table":       vpc.DataSourceIBMISVPCDefaultRoutingTable(),
 			"ibm_is_vpc_routing_table":               vpc.DataSourceIBMIsVPCRoutingTable(),
 			"ibm_is_vpc_routing_tables":              vpc.DataSourceIBMISVPCRoutingTables(),
@@ -890,6 +893,7 @@ func Provider() *schema.Provider {
 			// Added for Secrets Manager
 			"ibm_sm_secret_group":  secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretGroup()),
 			"ibm_sm_secret_groups": secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretGroups()),
+			"ibm_sm_secret_version_locks": secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmSecretVersionLocks()),
 			"ibm_sm_private_certificate_configuration_intermediate_ca":           secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPrivateCertificateConfigurationIntermediateCA()),
 			"ibm_sm_private_certificate_configuration_root_ca":                   secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPrivateCertificateConfigurationRootCA()),
 			"ibm_sm_private_certificate_configuration_template":                  secretsmanager.AddInstanceFields(secretsmanager.DataSourceIbmSmPrivateCertificateConfigurationTemplate()),
@@ -1254,6 +1258,7 @@ func Provider() *schema.Provider {
 
 			"ibm_cis":                                 cis.ResourceIBMCISInstance(),
 			"ibm_database":                            database.ResourceIBMDatabaseInstance(),
+			"ibm_database_user":                       database.ResourceIBMDatabaseUser(),
 			"ibm_db2":                                 db2.ResourceIBMDb2Instance(),
 			"ibm_cis_domain":                          cis.ResourceIBMCISDomain(),
 			"ibm_cis_domain_settings":                 cis.ResourceIBMCISSettings(),
@@ -1329,6 +1334,7 @@ func Provider() *schema.Provider {
 			"ibm_container_bind_service":                    kubernetes.ResourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                     kubernetes.ResourceIBMContainerWorkerPool(),
 			"ibm_container_worker_pool_zone_attachment":     kubernetes.ResourceIBMContainerWorkerPoolZoneAttachment(),
+			"ibm_container_worker_pool_autoscaling":         kubernetes.ResourceIBMContainerWorkerPoolAutoscaling(),
 			"ibm_container_storage_attachment":              kubernetes.ResourceIBMContainerVpcWorkerVolumeAttachment(),
 			"ibm_container_nlb_dns":                         kubernetes.ResourceIBMContainerNlbDns(),
 			"ibm_container_dedicated_host_pool":             kubernetes.ResourceIBMContainerDedicatedHostPool(),
@@ -1340,7 +1346,9 @@ func Provider() *schema.Provider {
 			"ibm_cos_bucket_replication_rule":               cos.ResourceIBMCOSBucketReplicationConfiguration(),
 			"ibm_cos_bucket_object":                         cos.ResourceIBMCOSBucketObject(),
 			"ibm_cos_bucket_object_lock_configuration":      cos.ResourceIBMCOSBucketObjectlock(),
+			"ibm_cos_bucket_objects_sync":                   cos.ResourceIBMCOSBucketObjectsSync(),
 			"ibm_cos_bucket_website_configuration":          cos.ResourceIBMCOSBucketWebsiteConfiguration(),
+			"ibm_cos_bucket_cors_configuration":             cos.ResourceIBMCOSBucketCorsConfiguration(),
 			"ibm_cos_bucket_lifecycle_configuration":        cos.ResourceIBMCOSBucketLifecycleConfiguration(),
 			"ibm_cos_backup_vault":                          cos.ResourceIBMCOSBackupVault(),
 			"ibm_cos_backup_policy":                         cos.ResourceIBMCOSBackupPolicy(),
@@ -1589,6 +1597,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_ike_policy":                      power.ResourceIBMPIIKEPolicy(),
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_image":                           power.ResourceIBMPIImage(),
+			"ibm_pi_image_replica":                   power.ResourceIBMPIImageReplica(),
 			"ibm_pi_instance_action":                 power.ResourceIBMPIInstanceAction(),
 			"ibm_pi_instance_snapshot":               power.ResourceIBMPIInstanceSnapshot(),
 			"ibm_pi_instance_vpmem_volumes":          power.ResourceIBMPIInstanceVpmemVolumes(),
@@ -1683,6 +1692,7 @@ func Provider() *schema.Provider {
 
 			// Added for Secrets Manager
 			"ibm_sm_secret_group":                                                secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretGroup()),
+			"ibm_sm_secret_version_locks":                                        secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmSecretVersionLocks()),
 			"ibm_sm_arbitrary_secret":                                            secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmArbitrarySecret()),
 			"ibm_sm_imported_certificate":                                        secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmImportedCertificate()),
 			"ibm_sm_public_certificate":                                          secretsmanager.AddInstanceFields(secretsmanager.ResourceIbmSmPublicCertificate()),
@@ -1924,11 +1934,11 @@ func wrapProvider(provider schema.Provider) schema.Provider {
 	wrappedDataSourcesMap := map[string]*schema.Resource{}
 
 	for key, value := range provider.ResourcesMap {
-		wrappedResourcesMap[key] = wrapResource(key, value)
+		wrappedResourcesMap[key] = wrapResource(key, wrapRegionalResource(key, value, false))
 	}
 
 	for key, value := range provider.DataSourcesMap {
-		wrappedDataSourcesMap[key] = wrapDataSource(key, value)
+		wrappedDataSourcesMap[key] = wrapDataSource(key, wrapRegionalResource(key, value, true))
 	}
 
 	return schema.Provider{
@@ -2533,6 +2543,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_iam_access_group_policy":    iampolicy.DataSourceIBMIAMAccessGroupPolicyValidator(),
 				"ibm_iam_service_policy":         iampolicy.DataSourceIBMIAMServicePolicyValidator(),
 				"ibm_iam_trusted_profile_policy": iampolicy.DataSourceIBMIAMTrustedProfilePolicyValidator(),
+
+				"ibm_satellite_attach_host_script": satellite.DataSourceIBMSatelliteAttachHostScriptValidator(),
 			},
 		}
 	})
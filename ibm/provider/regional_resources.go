@@ -0,0 +1,172 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package provider
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// regionalOverride describes the argument that selects the region or zone of
+// the resources of a regional service.
+type regionalOverride struct {
+	prefix                string
+	argument              string
+	description           string
+	dataSourceDescription string
+
+	// session returns the session for the selected location.
+	session func(sess conns.ClientSession, location string) conns.ClientSession
+	// providerLocation returns the location configured on the provider.
+	providerLocation func(sess conns.ClientSession) (string, error)
+}
+
+var regionalOverrides = []regionalOverride{
+	{
+		prefix:                "ibm_is_",
+		argument:              "region",
+		description:           "The region in which the resource is managed. Defaults to the provider region.",
+		dataSourceDescription: "The region in which to look up the resource. Defaults to the provider region.",
+		session: func(sess conns.ClientSession, region string) conns.ClientSession {
+			return sess.ForRegion(region)
+		},
+		providerLocation: func(sess conns.ClientSession) (string, error) {
+			bxSession, err := sess.BluemixSession()
+			if err != nil {
+				return "", err
+			}
+			return bxSession.Config.Region, nil
+		},
+	},
+	{
+		prefix:                "ibm_pi_",
+		argument:              "pi_zone",
+		description:           "The zone of the workspace. Defaults to the provider zone.",
+		dataSourceDescription: "The zone of the workspace. Defaults to the provider zone.",
+		session: func(sess conns.ClientSession, zone string) conns.ClientSession {
+			return sess.ForZone(zone)
+		},
+		providerLocation: func(sess conns.ClientSession) (string, error) {
+			piSession, err := sess.IBMPISession()
+			if err != nil || piSession.Options == nil {
+				return "", err
+			}
+			return piSession.Options.Zone, nil
+		},
+	},
+}
+
+// wrapRegionalResource adds the region argument to the VPC resources, and the
+// pi_zone argument to the Power Systems resources, that do not define it
+// themselves. Their operations then run with the session for the selected
+// region or zone, and the provider location is recorded when none is selected.
+func wrapRegionalResource(name string, resource *schema.Resource, isDataSource bool) *schema.Resource {
+	for _, override := range regionalOverrides {
+		if !strings.HasPrefix(name, override.prefix) {
+			continue
+		}
+		if _, ok := resource.Schema[override.argument]; ok {
+			return resource
+		}
+		argument := &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: override.description,
+		}
+		if isDataSource {
+			argument.ForceNew = false
+			argument.Description = override.dataSourceDescription
+		}
+		resource.Schema[override.argument] = argument
+		override.wrap(resource)
+		return resource
+	}
+	return resource
+}
+
+func (o regionalOverride) wrap(resource *schema.Resource) {
+	resource.CreateContext = o.wrapContextFunction(resource.CreateContext)
+	resource.ReadContext = o.wrapContextFunction(resource.ReadContext)
+	resource.UpdateContext = o.wrapContextFunction(resource.UpdateContext)
+	resource.DeleteContext = o.wrapContextFunction(resource.DeleteContext)
+	resource.CreateWithoutTimeout = o.wrapContextFunction(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = o.wrapContextFunction(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = o.wrapContextFunction(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = o.wrapContextFunction(resource.DeleteWithoutTimeout)
+	resource.Create = o.wrapFunction(resource.Create)
+	resource.Read = o.wrapFunction(resource.Read)
+	resource.Update = o.wrapFunction(resource.Update)
+	resource.Delete = o.wrapFunction(resource.Delete)
+	if exists := resource.Exists; exists != nil {
+		resource.Exists = func(d *schema.ResourceData, meta interface{}) (bool, error) {
+			return exists(d, o.sessionFor(d, meta))
+		}
+	}
+	if customizeDiff := resource.CustomizeDiff; customizeDiff != nil {
+		resource.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			return customizeDiff(ctx, diff, o.sessionFor(diff, meta))
+		}
+	}
+}
+
+func (o regionalOverride) wrapContextFunction(function func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if function == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		diags := function(ctx, d, o.sessionFor(d, meta))
+		if diags.HasError() {
+			return diags
+		}
+		if err := o.setProviderLocation(d, meta); err != nil {
+			return append(diags, diag.FromErr(err)...)
+		}
+		return diags
+	}
+}
+
+func (o regionalOverride) wrapFunction(function func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	if function == nil {
+		return nil
+	}
+	return func(d *schema.ResourceData, meta interface{}) error {
+		if err := function(d, o.sessionFor(d, meta)); err != nil {
+			return err
+		}
+		return o.setProviderLocation(d, meta)
+	}
+}
+
+// sessionFor returns the session for the location selected by d, which is a
+// *schema.ResourceData or, at plan time, a *schema.ResourceDiff.
+func (o regionalOverride) sessionFor(d interface{ Get(string) interface{} }, meta interface{}) interface{} {
+	location, _ := d.Get(o.argument).(string)
+	if location == "" {
+		return meta
+	}
+	return o.session(meta.(conns.ClientSession), location)
+}
+
+// setProviderLocation records the provider location on a resource that does
+// not select one, so that a later change of the provider location does not
+// move it.
+func (o regionalOverride) setProviderLocation(d *schema.ResourceData, meta interface{}) error {
+	if d.Id() == "" || d.Get(o.argument).(string) != "" {
+		return nil
+	}
+	location, err := o.providerLocation(meta.(conns.ClientSession))
+	if err != nil || location == "" {
+		// The resource does not depend on the provider location.
+		log.Printf("[DEBUG] Not recording the provider %s: %v", o.argument, err)
+		return nil
+	}
+	return d.Set(o.argument, location)
+}
@@ -0,0 +1,353 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/ibm-cos-sdk-go-config/v2/resourceconfigurationv1"
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &cosBackupVaultRestoreAction{}
+	_ action.ActionWithConfigure = &cosBackupVaultRestoreAction{}
+)
+
+func NewCosBackupVaultRestoreAction() action.Action {
+	return &cosBackupVaultRestoreAction{}
+}
+
+type cosBackupVaultRestoreAction struct {
+	client *rc.ResourceConfigurationV1
+}
+
+type backupVaultRestoreModel struct {
+	BackupVaultName    types.String `tfsdk:"backup_vault_name"`
+	RecoveryRangeID    types.String `tfsdk:"recovery_range_id"`
+	SourceBucketCrn    types.String `tfsdk:"source_bucket_crn"`
+	TargetBucketCrn    types.String `tfsdk:"target_bucket_crn"`
+	RestorePointInTime types.String `tfsdk:"restore_point_in_time"`
+	WaitTimeout        types.Int64  `tfsdk:"wait_timeout"`
+	NoWait             types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *cosBackupVaultRestoreAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_cos_backup_vault_restore"
+}
+
+func (a *cosBackupVaultRestoreAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Restores a COS bucket from a backup vault to a point in time and optionally waits for the restore to complete. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"backup_vault_name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the backup vault that holds the recovery range.",
+			},
+			"recovery_range_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the recovery range to restore from. If not specified, the recovery range of source_bucket_crn that contains restore_point_in_time is used.",
+			},
+			"source_bucket_crn": schema.StringAttribute{
+				Optional:    true,
+				Description: "The CRN of the bucket that was backed up. Required when recovery_range_id is not specified.",
+			},
+			"target_bucket_crn": schema.StringAttribute{
+				Required:    true,
+				Description: "The CRN of the bucket that the objects are restored into. The bucket must have versioning enabled.",
+			},
+			"restore_point_in_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "The point in time to restore to, in RFC 3339 format. If not specified, the end of the recovery range is used.",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the restore to complete. If not specified, defaults to 3600 seconds. Ignored when no_wait is true.",
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after starting the restore without waiting for completion. Default: false",
+			},
+		},
+	}
+}
+
+func (a *cosBackupVaultRestoreAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.CosConfigV1API()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create COS Resource Configuration Client",
+			"An unexpected error occurred when creating the COS resource configuration client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"COS Resource Configuration Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *cosBackupVaultRestoreAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config backupVaultRestoreModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.RecoveryRangeID.IsNull() && config.SourceBucketCrn.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Recovery Range",
+			"Either recovery_range_id or source_bucket_crn must be specified.",
+		)
+		return
+	}
+
+	var pointInTime *time.Time
+	if !config.RestorePointInTime.IsNull() {
+		t, err := time.Parse(time.RFC3339, config.RestorePointInTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid Restore Point In Time",
+				fmt.Sprintf("restore_point_in_time must be in RFC 3339 format, for example 2026-01-02T15:04:05Z: %s", err.Error()),
+			)
+			return
+		}
+		pointInTime = &t
+	}
+
+	waitTimeout := 3600 * time.Second
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	backupVaultName := config.BackupVaultName.ValueString()
+	recoveryRange, err := a.findRecoveryRange(ctx, backupVaultName, config.RecoveryRangeID.ValueString(), config.SourceBucketCrn.ValueString(), pointInTime)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Recovery Range Not Found",
+			fmt.Sprintf("Unable to find a recovery range in backup vault '%s': %s", backupVaultName, err.Error()),
+		)
+		return
+	}
+
+	if pointInTime == nil {
+		if recoveryRange.RangeEndTime == nil {
+			resp.Diagnostics.AddError(
+				"Missing Restore Point In Time",
+				fmt.Sprintf("Recovery range '%s' has no end time, specify restore_point_in_time.", *recoveryRange.RecoveryRangeID),
+			)
+			return
+		}
+		t := time.Time(*recoveryRange.RangeEndTime)
+		pointInTime = &t
+	}
+
+	restorePointInTime := strfmt.DateTime(*pointInTime)
+	createOptions := &rc.CreateRestoreOptions{
+		BackupVaultName:    core.StringPtr(backupVaultName),
+		RecoveryRangeID:    recoveryRange.RecoveryRangeID,
+		RestoreType:        core.StringPtr(rc.CreateRestoreOptions_RestoreType_InPlace),
+		RestorePointInTime: &restorePointInTime,
+		TargetResourceCrn:  core.StringPtr(config.TargetBucketCrn.ValueString()),
+	}
+	restore, response, err := a.client.CreateRestoreWithContext(ctx, createOptions)
+	if err != nil {
+		statusCode := 0
+		if response != nil {
+			statusCode = response.StatusCode
+		}
+		resp.Diagnostics.AddError(
+			"Restore Creation Failed",
+			fmt.Sprintf("Failed to start the restore from recovery range '%s' (HTTP %d): %s", *recoveryRange.RecoveryRangeID, statusCode, err.Error()),
+		)
+		return
+	}
+
+	restoreID := *restore.RestoreID
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore '%s' of recovery range '%s' to %s started", restoreID, *recoveryRange.RecoveryRangeID, pointInTime.Format(time.RFC3339)),
+	})
+
+	if !config.NoWait.IsNull() && config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Restore '%s' submitted (no-wait mode)", restoreID),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for restore '%s' to complete (timeout: %v)...", restoreID, waitTimeout),
+	})
+
+	if err := a.waitForCompletion(ctx, backupVaultName, restoreID, waitTimeout, resp.SendProgress); err != nil {
+		resp.Diagnostics.AddError(
+			"Restore Failed",
+			fmt.Sprintf("Restore '%s' did not complete successfully: %s", restoreID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Restore '%s' completed successfully", restoreID),
+	})
+}
+
+// findRecoveryRange returns the recovery range with the given ID, or else the recovery range of the
+// source bucket that contains pointInTime, or the latest one if pointInTime is nil.
+func (a *cosBackupVaultRestoreAction) findRecoveryRange(ctx context.Context, backupVaultName, recoveryRangeID, sourceBucketCrn string, pointInTime *time.Time) (*rc.RecoveryRange, error) {
+	ranges, err := listCosRecoveryRanges(ctx, a.client, backupVaultName, sourceBucketCrn, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *rc.RecoveryRange
+	for i := range ranges {
+		recoveryRange := &ranges[i]
+		if recoveryRangeID != "" {
+			if recoveryRange.RecoveryRangeID != nil && *recoveryRange.RecoveryRangeID == recoveryRangeID {
+				return recoveryRange, nil
+			}
+			continue
+		}
+		if recoveryRange.RangeStartTime == nil || recoveryRange.RangeEndTime == nil {
+			continue
+		}
+		start, end := time.Time(*recoveryRange.RangeStartTime), time.Time(*recoveryRange.RangeEndTime)
+		if pointInTime != nil {
+			if !pointInTime.Before(start) && !pointInTime.After(end) {
+				return recoveryRange, nil
+			}
+			continue
+		}
+		if match == nil || end.After(time.Time(*match.RangeEndTime)) {
+			match = recoveryRange
+		}
+	}
+	if match != nil {
+		return match, nil
+	}
+	if recoveryRangeID != "" {
+		return nil, fmt.Errorf("recovery range '%s' does not exist", recoveryRangeID)
+	}
+	if pointInTime != nil {
+		return nil, fmt.Errorf("no recovery range of '%s' contains %s", sourceBucketCrn, pointInTime.Format(time.RFC3339))
+	}
+	return nil, fmt.Errorf("no recovery range of '%s' exists", sourceBucketCrn)
+}
+
+func (a *cosBackupVaultRestoreAction) waitForCompletion(ctx context.Context, backupVaultName, restoreID string, timeout time.Duration, sendProgress func(action.InvokeProgressEvent)) error {
+	deadline := time.Now().Add(timeout)
+	pollInterval := 10 * time.Second
+	maxInterval := 60 * time.Second
+	backoffMultiplier := 1.5
+	lastStatus := ""
+	lastProgress := int64(-1)
+
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		default:
+		}
+
+		restore, response, err := a.client.GetRestoreWithContext(ctx, &rc.GetRestoreOptions{
+			BackupVaultName: core.StringPtr(backupVaultName),
+			RestoreID:       core.StringPtr(restoreID),
+		})
+		if err != nil {
+			if response == nil || response.StatusCode == 429 || response.StatusCode >= 500 {
+				time.Sleep(pollInterval)
+				continue
+			}
+			return fmt.Errorf("failed to get restore status: %w", err)
+		}
+
+		currentStatus := ""
+		if restore.RestoreStatus != nil {
+			currentStatus = *restore.RestoreStatus
+		}
+		progress := int64(-1)
+		if restore.RestorePercentProgress != nil {
+			progress = *restore.RestorePercentProgress
+		}
+		if currentStatus != lastStatus || progress != lastProgress {
+			message := fmt.Sprintf("Restore status: %s", currentStatus)
+			if progress >= 0 {
+				message = fmt.Sprintf("%s (%d%%)", message, progress)
+			}
+			sendProgress(action.InvokeProgressEvent{Message: message})
+			lastStatus = currentStatus
+			lastProgress = progress
+		}
+
+		switch currentStatus {
+		case rc.Restore_RestoreStatus_Complete:
+			return nil
+		case rc.Restore_RestoreStatus_Failed:
+			reason := "unknown error"
+			if restore.ErrorCause != nil {
+				reason = *restore.ErrorCause
+			}
+			return fmt.Errorf("restore failed: %s", reason)
+		case rc.Restore_RestoreStatus_Initializing, rc.Restore_RestoreStatus_Running, "":
+		default:
+			return fmt.Errorf("unknown restore status: %s", currentStatus)
+		}
+
+		time.Sleep(pollInterval)
+		pollInterval = time.Duration(float64(pollInterval) * backoffMultiplier)
+		if pollInterval > maxInterval {
+			pollInterval = maxInterval
+		}
+	}
+
+	return fmt.Errorf("timeout after %v waiting for restore completion", timeout)
+}
+
+// listCosRecoveryRanges returns all recovery ranges of the backup vault, optionally only those
+// of sourceResourceCrn or only the latest one of each source resource.
+func listCosRecoveryRanges(ctx context.Context, client *rc.ResourceConfigurationV1, backupVaultName, sourceResourceCrn string, latest bool) ([]rc.RecoveryRange, error) {
+	options := &rc.ListRecoveryRangesOptions{
+		BackupVaultName: core.StringPtr(backupVaultName),
+	}
+	if sourceResourceCrn != "" {
+		options.SourceResourceCrn = core.StringPtr(sourceResourceCrn)
+	}
+	if latest {
+		options.Latest = core.StringPtr("true")
+	}
+
+	var ranges []rc.RecoveryRange
+	for {
+		collection, _, err := client.ListRecoveryRangesWithContext(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+		ranges = append(ranges, collection.RecoveryRanges...)
+		if collection.Next == nil || collection.Next.Token == nil || *collection.Next.Token == "" {
+			return ranges, nil
+		}
+		options.Token = collection.Next.Token
+	}
+}
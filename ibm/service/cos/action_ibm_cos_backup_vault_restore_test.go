@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBackupVaultRestoreAction_basic(t *testing.T) {
+	bucketName := fmt.Sprintf("terraform-restore-target%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheckCOS(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBackupVaultRestoreActionConfig_basic(acc.CosCRN, bucketName, acc.BackupVaultName, acc.BucketCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket.target", "crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBackupVaultRestoreActionConfig_basic(instanceCRN string, bucketName string, backupVaultName string, sourceCRN string) string {
+	return fmt.Sprintf(`
+	resource "ibm_cos_bucket" "target" {
+		bucket_name          = "%s"
+		resource_instance_id = "%s"
+		region_location      = "us-south"
+		storage_class        = "standard"
+		object_versioning {
+			enable = true
+		}
+	}
+
+	resource "terraform_data" "trigger_restore" {
+		input = ibm_cos_bucket.target.crn
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_cos_backup_vault_restore.restore]
+			}
+		}
+	}
+
+	action "ibm_cos_backup_vault_restore" "restore" {
+		config {
+			backup_vault_name = "%s"
+			source_bucket_crn = "%s"
+			target_bucket_crn = ibm_cos_bucket.target.crn
+		}
+	}
+	`, bucketName, instanceCRN, backupVaultName, sourceCRN)
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMCosBackupVaultRecoveryRanges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosBackupVaultRecoveryRangesRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_vault_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the backup vault.",
+			},
+			"source_resource_crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the recovery ranges of the bucket with this CRN.",
+			},
+			"latest": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only list the latest recovery range of each bucket.",
+			},
+			"recovery_ranges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Recovery ranges of the backup vault.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recovery_range_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the recovery range.",
+						},
+						"source_resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "CRN of the bucket that was backed up.",
+						},
+						"backup_policy_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the backup policy that created the recovery range.",
+						},
+						"range_start_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Earliest point in time that can be restored.",
+						},
+						"range_end_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Latest point in time that can be restored.",
+						},
+						"range_create_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the recovery range was created.",
+						},
+						"delete_after_days": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of days the recovery range is retained. -1 means indefinite retention.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMCosBackupVaultRecoveryRangesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rcClient, err := meta.(conns.ClientSession).CosConfigV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	backupVaultName := d.Get("backup_vault_name").(string)
+	sourceResourceCrn := d.Get("source_resource_crn").(string)
+
+	ranges, err := listCosRecoveryRanges(ctx, rcClient, backupVaultName, sourceResourceCrn, d.Get("latest").(bool))
+	if err != nil {
+		return diag.Errorf("[ERROR] Error listing recovery ranges of backup vault %s: %s", backupVaultName, err)
+	}
+
+	recoveryRanges := make([]map[string]interface{}, 0, len(ranges))
+	for _, recoveryRange := range ranges {
+		rangeMap := map[string]interface{}{}
+		if recoveryRange.RecoveryRangeID != nil {
+			rangeMap["recovery_range_id"] = *recoveryRange.RecoveryRangeID
+		}
+		if recoveryRange.SourceResourceCrn != nil {
+			rangeMap["source_resource_crn"] = *recoveryRange.SourceResourceCrn
+		}
+		if recoveryRange.BackupPolicyName != nil {
+			rangeMap["backup_policy_name"] = *recoveryRange.BackupPolicyName
+		}
+		if recoveryRange.RangeStartTime != nil {
+			rangeMap["range_start_time"] = time.Time(*recoveryRange.RangeStartTime).Format(time.RFC3339)
+		}
+		if recoveryRange.RangeEndTime != nil {
+			rangeMap["range_end_time"] = time.Time(*recoveryRange.RangeEndTime).Format(time.RFC3339)
+		}
+		if recoveryRange.RangeCreateTime != nil {
+			rangeMap["range_create_time"] = time.Time(*recoveryRange.RangeCreateTime).Format(time.RFC3339)
+		}
+		if recoveryRange.Retention != nil && recoveryRange.Retention.DeleteAfterDays != nil {
+			rangeMap["delete_after_days"] = int(*recoveryRange.Retention.DeleteAfterDays)
+		}
+		recoveryRanges = append(recoveryRanges, rangeMap)
+	}
+
+	d.SetId(fmt.Sprintf("%s:recovery-ranges:%s", backupVaultName, strings.TrimSpace(sourceResourceCrn)))
+	if err := d.Set("recovery_ranges", recoveryRanges); err != nil {
+		return diag.Errorf("[ERROR] Error setting recovery_ranges: %s", err)
+	}
+	return nil
+}
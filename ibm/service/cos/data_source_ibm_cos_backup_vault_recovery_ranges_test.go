@@ -0,0 +1,40 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBackupVaultRecoveryRangesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBackupVaultRecoveryRangesDataSourceConfig_basic(acc.BackupVaultName, acc.BucketCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cos_backup_vault_recovery_ranges.ranges", "id"),
+					resource.TestCheckResourceAttr("data.ibm_cos_backup_vault_recovery_ranges.ranges", "recovery_ranges.0.source_resource_crn", acc.BucketCRN),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_backup_vault_recovery_ranges.ranges", "recovery_ranges.0.recovery_range_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_cos_backup_vault_recovery_ranges.ranges", "recovery_ranges.0.range_end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBackupVaultRecoveryRangesDataSourceConfig_basic(name string, sourceCRN string) string {
+	return fmt.Sprintf(`
+	data "ibm_cos_backup_vault_recovery_ranges" "ranges" {
+		backup_vault_name   = "%s"
+		source_resource_crn = "%s"
+		latest              = true
+	}`, name, sourceCRN)
+}
@@ -0,0 +1,551 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cosDeleteObjectsBatchSize is the maximum number of keys of a DeleteObjects request.
+const cosDeleteObjectsBatchSize = 1000
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_directory": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Local directory whose files are synchronized to the bucket",
+			},
+			"key_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "",
+				Description: "Prefix prepended to the relative path of each file to build the object key",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of relative file paths that are not synchronized. `*` does not match `/`, a `**` path segment matches any number of directories",
+			},
+			"delete_removed": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete objects under key_prefix that have no matching local file, including objects that were not uploaded by this resource",
+			},
+			"multipart_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntAtLeast(5),
+				Description:  "Part size in MiB for multipart uploads. Files larger than the part size are uploaded in parts",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 100),
+				Description:  "Number of files uploaded in parallel",
+			},
+			"file_hashes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "MD5 hexdigest of each managed object, by object key",
+			},
+			"managed_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Keys of the objects managed by this resource",
+			},
+			"unmanaged_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Keys of the objects under key_prefix that are not managed by this resource. They are deleted on apply when delete_removed is set",
+			},
+		},
+	}
+}
+
+// cosSyncFile is a local file of the source directory.
+type cosSyncFile struct {
+	path        string
+	md5         string
+	contentType string
+}
+
+func resourceIBMCOSBucketObjectsSyncCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	// The files are only known at apply time
+	if !d.NewValueKnown("source_directory") || !d.NewValueKnown("key_prefix") || !d.NewValueKnown("exclude") {
+		for _, key := range []string{"file_hashes", "managed_keys", "unmanaged_keys"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	files, err := scanCOSSyncDirectory(d.Get("source_directory").(string), d.Get("key_prefix").(string), flex.ExpandStringList(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return err
+	}
+	hashes := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		hashes[key] = file.md5
+	}
+	hashesChanged := !reflect.DeepEqual(hashes, d.Get("file_hashes").(map[string]interface{}))
+	if hashesChanged {
+		if err := d.SetNew("file_hashes", hashes); err != nil {
+			return err
+		}
+		if err := d.SetNew("managed_keys", sortedCOSSyncKeys(hashes)); err != nil {
+			return err
+		}
+	}
+
+	// Objects that Read found in the bucket without a managed file are deleted on apply
+	if d.Get("delete_removed").(bool) {
+		if len(d.Get("unmanaged_keys").([]interface{})) > 0 {
+			return d.SetNew("unmanaged_keys", []string{})
+		}
+		return nil
+	}
+	if hashesChanged {
+		return d.SetNewComputed("unmanaged_keys")
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	if err := syncCOSBucketObjects(ctx, d, m, map[string]interface{}{}); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:sync:%s:location:%s", bucketCRN, d.Get("key_prefix").(string), d.Get("bucket_location").(string)))
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+// resourceIBMCOSBucketObjectsSyncRead drops the managed objects that were deleted or
+// changed in the bucket from file_hashes, so that the next plan uploads them again, and
+// records the objects that are not managed in unmanaged_keys, so that the next plan
+// deletes them when delete_removed is set.
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSSyncS3Client(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	remote, err := listCOSSyncObjects(s3Client, bucketName, d.Get("key_prefix").(string))
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchBucket {
+			log.Printf("[WARN] COS bucket (%s) was not found, removing the objects sync from state", bucketName)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	hashes := map[string]interface{}{}
+	for key, hash := range d.Get("file_hashes").(map[string]interface{}) {
+		etag, ok := remote[key]
+		if !ok {
+			log.Printf("[WARN] COS bucket (%s) object (%s) was deleted outside of Terraform", bucketName, key)
+			continue
+		}
+		// Multipart uploads have an ETag that is not the MD5 of the object
+		if !strings.Contains(etag, "-") && etag != hash.(string) {
+			log.Printf("[WARN] COS bucket (%s) object (%s) was changed outside of Terraform", bucketName, key)
+			continue
+		}
+		hashes[key] = hash
+	}
+	unmanaged := []string{}
+	for key := range remote {
+		if _, ok := hashes[key]; !ok {
+			unmanaged = append(unmanaged, key)
+		}
+	}
+	sort.Strings(unmanaged)
+	d.Set("file_hashes", hashes)
+	d.Set("managed_keys", sortedCOSSyncKeys(hashes))
+	d.Set("unmanaged_keys", unmanaged)
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	oldHashes, _ := d.GetChange("file_hashes")
+	if err := syncCOSBucketObjects(ctx, d, m, oldHashes.(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSSyncS3Client(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keys := sortedCOSSyncKeys(d.Get("file_hashes").(map[string]interface{}))
+	if err := deleteCOSSyncObjects(s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// syncCOSBucketObjects uploads the local files that differ from oldHashes or from the
+// bucket, and deletes the objects that no longer have a local file.
+func syncCOSBucketObjects(ctx context.Context, d *schema.ResourceData, m interface{}, oldHashes map[string]interface{}) error {
+	s3Client, bucketName, err := getCOSSyncS3Client(d, m)
+	if err != nil {
+		return err
+	}
+	keyPrefix := d.Get("key_prefix").(string)
+	files, err := scanCOSSyncDirectory(d.Get("source_directory").(string), keyPrefix, flex.ExpandStringList(d.Get("exclude").([]interface{})))
+	if err != nil {
+		return err
+	}
+	remote, err := listCOSSyncObjects(s3Client, bucketName, keyPrefix)
+	if err != nil {
+		return err
+	}
+
+	uploads := []string{}
+	for key, file := range files {
+		etag, exists := remote[key]
+		if !exists || (!strings.Contains(etag, "-") && etag != file.md5) || oldHashes[key] != file.md5 {
+			uploads = append(uploads, key)
+		}
+	}
+	sort.Strings(uploads)
+	log.Printf("[INFO] Uploading %d of %d files to COS bucket (%s)", len(uploads), len(files), bucketName)
+	if err := uploadCOSSyncFiles(ctx, s3Client, bucketName, files, uploads, d.Get("multipart_part_size").(int), d.Get("upload_concurrency").(int)); err != nil {
+		return err
+	}
+
+	deletes := []string{}
+	for key := range oldHashes {
+		if _, ok := files[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+	if d.Get("delete_removed").(bool) {
+		for key := range remote {
+			if _, ok := files[key]; !ok {
+				if _, managed := oldHashes[key]; !managed {
+					deletes = append(deletes, key)
+				}
+			}
+		}
+	}
+	sort.Strings(deletes)
+	if err := deleteCOSSyncObjects(s3Client, bucketName, deletes); err != nil {
+		return err
+	}
+
+	hashes := make(map[string]interface{}, len(files))
+	for key, file := range files {
+		hashes[key] = file.md5
+	}
+	d.Set("file_hashes", hashes)
+	return nil
+}
+
+func getCOSSyncS3Client(d *schema.ResourceData, m interface{}) (*s3.S3, string, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return nil, "", fmt.Errorf("[ERROR] %s is not a COS bucket CRN", bucketCRN)
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+	endpointType := d.Get("endpoint_type").(string)
+	schET := os.Getenv("IBMCLOUD_ENV_SCH_COS_ENDPOINT_OVERRIDE")
+	if endpointType != "" && endpointType == "private" && schET != "" {
+		endpointType = schET
+	}
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+	s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), endpointType, instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+// scanCOSSyncDirectory returns the files of dir by object key.
+func scanCOSSyncDirectory(dir, keyPrefix string, exclude []string) (map[string]cosSyncFile, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("[ERROR] Source directory (%s) is not a directory", dir)
+	}
+
+	files := map[string]cosSyncFile{}
+	err = filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		for _, pattern := range exclude {
+			if matchCOSSyncExclude(pattern, rel) {
+				return nil
+			}
+		}
+		hash, contentType, err := hashCOSSyncFile(path)
+		if err != nil {
+			return err
+		}
+		files[keyPrefix+rel] = cosSyncFile{path: path, md5: hash, contentType: contentType}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source directory (%s): %s", dir, err)
+	}
+	return files, nil
+}
+
+// matchCOSSyncExclude reports whether the relative path rel matches the exclude
+// pattern. Each path segment is matched with path.Match, so `*` does not match `/`,
+// and a `**` segment matches any number of directories.
+func matchCOSSyncExclude(pattern, rel string) bool {
+	return matchCOSSyncSegments(strings.Split(pattern, "/"), strings.Split(rel, "/"))
+}
+
+func matchCOSSyncSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchCOSSyncSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if matched, _ := path.Match(pattern[0], segments[0]); !matched {
+		return false
+	}
+	return matchCOSSyncSegments(pattern[1:], segments[1:])
+}
+
+// hashCOSSyncFile returns the MD5 hexdigest and the content type of the file. The
+// content type is inferred from the extension, or from the content if the extension
+// is unknown.
+func hashCOSSyncFile(path string) (string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(file, head)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return "", "", err
+		}
+		contentType = http.DetectContentType(head[:n])
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return "", "", err
+		}
+	}
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), contentType, nil
+}
+
+// listCOSSyncObjects returns the ETag of the objects under keyPrefix by object key.
+func listCOSSyncObjects(s3Client *s3.S3, bucketName, keyPrefix string) (map[string]string, error) {
+	objects := map[string]string{}
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+	err := s3Client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects[aws.StringValue(object.Key)] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s): %w", bucketName, err)
+	}
+	return objects, nil
+}
+
+func uploadCOSSyncFiles(ctx context.Context, s3Client *s3.S3, bucketName string, files map[string]cosSyncFile, keys []string, partSize, concurrency int) error {
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(partSize) * 1024 * 1024
+	})
+
+	work := make(chan string)
+	errs := make(chan error, len(keys))
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range work {
+				errs <- uploadCOSSyncFile(ctx, uploader, bucketName, key, files[key])
+			}
+		}()
+	}
+	for _, key := range keys {
+		work <- key
+	}
+	close(work)
+	wg.Wait()
+	close(errs)
+
+	var failed []string
+	for err := range errs {
+		if err != nil {
+			failed = append(failed, err.Error())
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("[ERROR] Error uploading %d of %d files to COS bucket (%s):\n%s", len(failed), len(keys), bucketName, strings.Join(failed, "\n"))
+	}
+	return nil
+}
+
+func uploadCOSSyncFile(ctx context.Context, uploader *s3manager.Uploader, bucketName, key string, file cosSyncFile) error {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("%s: %s", file.path, err)
+	}
+	defer body.Close()
+
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(bucketName),
+		Key:         aws.String(key),
+		Body:        body,
+		ContentType: aws.String(file.contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %s", key, err)
+	}
+	log.Printf("[DEBUG] Uploaded %s to COS bucket (%s) object (%s)", file.path, bucketName, key)
+	return nil
+}
+
+func deleteCOSSyncObjects(s3Client *s3.S3, bucketName string, keys []string) error {
+	for start := 0; start < len(keys); start += cosDeleteObjectsBatchSize {
+		end := start + cosDeleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		objects := make([]*s3.ObjectIdentifier, 0, end-start)
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting objects of COS bucket (%s): %s", bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			first := out.Errors[0]
+			return fmt.Errorf("[ERROR] Error deleting %d objects of COS bucket (%s), object (%s): %s", len(out.Errors), bucketName, aws.StringValue(first.Key), aws.StringValue(first.Message))
+		}
+	}
+	return nil
+}
+
+func sortedCOSSyncKeys(hashes map[string]interface{}) []string {
+	keys := make([]string, 0, len(hashes))
+	for key := range hashes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
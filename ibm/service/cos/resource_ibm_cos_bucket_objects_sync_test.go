@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	sourceDir := t.TempDir()
+	writeFile := func(path, content string) {
+		fullPath := filepath.Join(sourceDir, path)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(fullPath, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html><body>Acceptance Testing</body></html>")
+	writeFile("css/site.css", "body { color: black; }")
+	writeFile("site.js.map", "{}")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "managed_keys.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "managed_keys.0", "site/css/site.css"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "managed_keys.1", "site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects_sync.testacc", "file_hashes.site/index.html"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html><body>Acceptance Testing updated</body></html>")
+					writeFile("about.html", "<html><body>About</body></html>")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "managed_keys.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "managed_keys.0", "site/about.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects_sync.testacc", "managed_keys.1", "site/index.html"),
+				),
+			},
+			{
+				Config:             testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsSyncConfig(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			force_delete         = true
+		}
+		resource "ibm_cos_bucket_objects_sync" "testacc" {
+			bucket_crn       = ibm_cos_bucket.testacc.crn
+			bucket_location  = ibm_cos_bucket.testacc.region_location
+			source_directory = "%[3]s"
+			key_prefix       = "site/"
+			exclude          = ["*.map"]
+			delete_removed   = true
+		}`, name, instanceCRN, sourceDir)
+}
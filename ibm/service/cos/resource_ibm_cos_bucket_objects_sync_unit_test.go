@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func TestScanCOSSyncDirectory(t *testing.T) {
+	dir := t.TempDir()
+	contents := map[string]string{
+		"index.html":          "<html></html>",
+		"app.js.map":          "{}",
+		"js/app.js":           "console.log(1)",
+		"js/app.js.map":       "{}",
+		"drafts/post.md":      "draft",
+		"drafts/old/post.md":  "old draft",
+		"assets/img/logo.svg": "<svg></svg>",
+	}
+	for name, content := range contents {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	files, err := scanCOSSyncDirectory(dir, "site/", []string{"**/*.map", "drafts/**"})
+	if err != nil {
+		t.Fatalf("scanCOSSyncDirectory: %s", err)
+	}
+	keys := make([]string, 0, len(files))
+	for key := range files {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	want := []string{"site/assets/img/logo.svg", "site/index.html", "site/js/app.js"}
+	if len(keys) != len(want) {
+		t.Fatalf("got keys %v, want %v", keys, want)
+	}
+	for i := range want {
+		if keys[i] != want[i] {
+			t.Fatalf("got keys %v, want %v", keys, want)
+		}
+	}
+	for key, file := range files {
+		sum := md5.Sum([]byte(contents[key[len("site/"):]]))
+		if file.md5 != hex.EncodeToString(sum[:]) {
+			t.Errorf("%s: got md5 %s, want %s", key, file.md5, hex.EncodeToString(sum[:]))
+		}
+	}
+	if got := files["site/index.html"].contentType; got != "text/html; charset=utf-8" {
+		t.Errorf("index.html: got content type %q", got)
+	}
+
+	if _, err := scanCOSSyncDirectory(filepath.Join(dir, "index.html"), "", nil); err == nil {
+		t.Error("expected an error for a source directory that is a file")
+	}
+	if _, err := scanCOSSyncDirectory(filepath.Join(dir, "missing"), "", nil); err == nil {
+		t.Error("expected an error for a missing source directory")
+	}
+}
+
+func TestMatchCOSSyncExclude(t *testing.T) {
+	cases := []struct {
+		pattern string
+		rel     string
+		want    bool
+	}{
+		{"*.map", "app.js.map", true},
+		{"*.map", "js/app.js.map", false},
+		{"**/*.map", "app.js.map", true},
+		{"**/*.map", "js/vendor/app.js.map", true},
+		{"drafts/*", "drafts/post.md", true},
+		{"drafts/*", "drafts/old/post.md", false},
+		{"drafts/**", "drafts/old/post.md", true},
+		{"drafts/**", "published/post.md", false},
+		{"js/**/app.js", "js/app.js", true},
+		{"js/**/app.js", "js/a/b/app.js", true},
+	}
+	for _, c := range cases {
+		if got := matchCOSSyncExclude(c.pattern, c.rel); got != c.want {
+			t.Errorf("matchCOSSyncExclude(%q, %q) = %t, want %t", c.pattern, c.rel, got, c.want)
+		}
+	}
+}
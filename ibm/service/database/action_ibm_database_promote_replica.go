@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &databasePromoteReplicaAction{}
+	_ action.ActionWithConfigure = &databasePromoteReplicaAction{}
+)
+
+func NewDatabasePromoteReplicaAction() action.Action {
+	return &databasePromoteReplicaAction{}
+}
+
+type databasePromoteReplicaAction struct {
+	client *clouddatabasesv5.CloudDatabasesV5
+}
+
+type databasePromoteReplicaModel struct {
+	InstanceID        types.String `tfsdk:"instance_id"`
+	SkipInitialBackup types.Bool   `tfsdk:"skip_initial_backup"`
+	WaitTimeout       types.Int64  `tfsdk:"wait_timeout"`
+	NoWait            types.Bool   `tfsdk:"no_wait"`
+}
+
+func (a *databasePromoteReplicaAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_database_promote_replica"
+}
+
+func (a *databasePromoteReplicaAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Promotes a read-only replica of a Cloud Databases deployment to a standalone leader, for example during a regional outage of its leader. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The CRN of the read-only replica to promote.",
+			},
+			"skip_initial_backup": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the initial backup of the promoted deployment is skipped. The deployment becomes available more quickly, but there is no immediate backup. Default: false",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the promotion to complete. If not specified, defaults to 3600 seconds. Ignored when no_wait is true.",
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after starting the promotion without waiting for completion. Default: false",
+			},
+		},
+	}
+}
+
+func (a *databasePromoteReplicaAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	client, err := session.CloudDatabasesV5()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Cloud Databases Client",
+			"An unexpected error occurred when creating the Cloud Databases client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Cloud Databases Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	a.client = client
+}
+
+func (a *databasePromoteReplicaAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config databasePromoteReplicaModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := config.InstanceID.ValueString()
+	skipInitialBackup := !config.SkipInitialBackup.IsNull() && config.SkipInitialBackup.ValueBool()
+
+	waitTimeout := 3600 * time.Second
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	replica, err := isReadOnlyReplica(ctx, a.client, instanceID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Replica Lookup Failed",
+			err.Error(),
+		)
+		return
+	}
+	if !replica {
+		resp.Diagnostics.AddError(
+			"Not a Read-Only Replica",
+			fmt.Sprintf("Database '%s' is not a read-only replica, or has already been promoted.", instanceID),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Promoting read-only replica '%s' (skip initial backup: %t)", instanceID, skipInitialBackup),
+	})
+
+	promoteResponse, response, err := a.client.PromoteReadOnlyReplicaWithContext(ctx, &clouddatabasesv5.PromoteReadOnlyReplicaOptions{
+		ID: core.StringPtr(instanceID),
+		Promotion: map[string]interface{}{
+			"skip_initial_backup": skipInitialBackup,
+		},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Promotion Failed",
+			fmt.Sprintf("Failed to promote read-only replica '%s': %s\n%s", instanceID, err.Error(), response),
+		)
+		return
+	}
+
+	if promoteResponse.Task == nil || promoteResponse.Task.ID == nil {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Read-only replica '%s' promoted", instanceID),
+		})
+		return
+	}
+
+	taskID := *promoteResponse.Task.ID
+	if !config.NoWait.IsNull() && config.NoWait.ValueBool() {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Promotion task '%s' submitted (no-wait mode)", taskID),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for promotion task '%s' to complete (timeout: %v)...", taskID, waitTimeout),
+	})
+
+	tm := &TaskManager{
+		Client:     a.client,
+		InstanceID: instanceID,
+	}
+	err = tm.waitForTaskComplete(ctx, taskID, waitTimeout, func(task *clouddatabasesv5.Task) {
+		message := fmt.Sprintf("Promotion status: %s", *task.Status)
+		if task.ProgressPercent != nil {
+			message = fmt.Sprintf("%s (%d%%)", message, *task.ProgressPercent)
+		}
+		resp.SendProgress(action.InvokeProgressEvent{Message: message})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Promotion Failed",
+			fmt.Sprintf("Promotion of read-only replica '%s' did not complete successfully: %s", instanceID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Read-only replica '%s' promoted. Remove remote_leader_id from its ibm_database configuration to accept it as a leader.", instanceID),
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabasePromoteReplicaAction(t *testing.T) {
+	databaseResourceGroup := "default"
+
+	var replicaInstanceCRN string
+
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	readReplicaName := serviceName + "-replica"
+	replicaResource := "ibm_database." + readReplicaName
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acc.ConfigCompose(
+					testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup, serviceName),
+					testAccCheckIBMDatabaseInstancePostgresMinimal_ReadReplica(databaseResourceGroup, serviceName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(replicaResource, &replicaInstanceCRN),
+				),
+			},
+			{
+				Config: acc.ConfigCompose(
+					testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup, serviceName),
+					testAccCheckIBMDatabaseInstancePostgresMinimal_ReadReplica(databaseResourceGroup, serviceName),
+					testAccCheckIBMDatabasePromoteReplicaActionConfig(readReplicaName)),
+			},
+			{
+				// Accept the promoted replica in place
+				Config: acc.ConfigCompose(
+					testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup, serviceName),
+					testAccCheckIBMDatabaseInstancePostgresReadReplicaPromote(databaseResourceGroup, readReplicaName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseInstanceExists(replicaResource, &replicaInstanceCRN),
+					resource.TestCheckResourceAttr(replicaResource, "remote_leader_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabasePromoteReplicaActionConfig(readReplicaName string) string {
+	return fmt.Sprintf(`
+	action "ibm_database_promote_replica" "promote" {
+		config {
+			instance_id         = ibm_database.%[1]s.id
+			skip_initial_backup = true
+		}
+	}
+
+	resource "terraform_data" "trigger_promotion" {
+		input = ibm_database.%[1]s.id
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_database_promote_replica.promote]
+			}
+		}
+	}
+	`, readReplicaName)
+}
@@ -4,6 +4,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/url"
@@ -63,6 +64,7 @@ type TimeoutHelper struct {
 // Allows mocking
 type DeploymentTaskFetcher interface {
 	ListDeploymentTasks(opts *clouddatabasesv5.ListDeploymentTasksOptions) (*clouddatabasesv5.Tasks, *core.DetailedResponse, error)
+	GetTaskWithContext(ctx context.Context, opts *clouddatabasesv5.GetTaskOptions) (*clouddatabasesv5.GetTaskResponse, *core.DetailedResponse, error)
 }
 type TaskManager struct {
 	Client     DeploymentTaskFetcher
@@ -123,6 +125,74 @@ func (tm *TaskManager) matchingTaskInProgress(taskType string) (bool, *clouddata
 	return false, nil, nil
 }
 
+var taskPollInterval = 5 * time.Second
+
+// waitForTaskComplete polls the task until it completes, calling onProgress whenever its status or progress changes.
+func (tm *TaskManager) waitForTaskComplete(ctx context.Context, taskID string, timeout time.Duration, onProgress func(task *clouddatabasesv5.Task)) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(taskPollInterval)
+	defer ticker.Stop()
+
+	opts := &clouddatabasesv5.GetTaskOptions{
+		ID: core.StringPtr(taskID),
+	}
+
+	lastStatus, lastProgress := "", int64(-1)
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for database task %s to complete: %w", taskID, ctx.Err())
+		case <-ticker.C:
+			resp, _, err := tm.Client.GetTaskWithContext(ctx, opts)
+			if err != nil {
+				return fmt.Errorf("failed to get database task %s: %w", taskID, err)
+			}
+
+			// A task is no longer returned once it has completed
+			if resp.Task == nil || resp.Task.Status == nil {
+				return nil
+			}
+
+			var progress int64
+			if resp.Task.ProgressPercent != nil {
+				progress = *resp.Task.ProgressPercent
+			}
+			if onProgress != nil && (*resp.Task.Status != lastStatus || progress != lastProgress) {
+				onProgress(resp.Task)
+			}
+			lastStatus, lastProgress = *resp.Task.Status, progress
+
+			switch lastStatus {
+			case databaseTaskCompletedStatus, "":
+				return nil
+			case databaseTaskFailedStatus:
+				return fmt.Errorf("database task %s failed", taskID)
+			case databaseTaskExpiredStatus:
+				return fmt.Errorf("database task %s expired", taskID)
+			}
+		}
+	}
+}
+
+// isReadOnlyReplica reports whether the deployment is a read-only replica of another deployment.
+func isReadOnlyReplica(ctx context.Context, client *clouddatabasesv5.CloudDatabasesV5, instanceID string) (bool, error) {
+	remotes, response, err := client.ListRemotesWithContext(ctx, &clouddatabasesv5.ListRemotesOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to get remotes of database %s: %s\n%s", instanceID, err, response)
+	}
+
+	if remotes.Remotes == nil || remotes.Remotes.Leader == nil {
+		return false, nil
+	}
+
+	leader := *remotes.Remotes.Leader
+	return leader != "" && leader != instanceID, nil
+}
+
 func isAttrConfiguredInDiff(d *schema.ResourceDiff, k string) bool {
 	v, ok := d.GetOkExists(k)
 	if !ok {
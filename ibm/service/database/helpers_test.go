@@ -4,6 +4,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -99,6 +100,21 @@ func TestCalculateExpirationDatetime(t *testing.T) {
 type MockTaskClient struct {
 	Tasks []clouddatabasesv5.Task
 	Err   error
+
+	// Returned by successive GetTaskWithContext calls, nil once exhausted
+	TaskPolls []*clouddatabasesv5.Task
+}
+
+func (m *MockTaskClient) GetTaskWithContext(ctx context.Context, opts *clouddatabasesv5.GetTaskOptions) (*clouddatabasesv5.GetTaskResponse, *core.DetailedResponse, error) {
+	if m.Err != nil {
+		return nil, nil, m.Err
+	}
+	if len(m.TaskPolls) == 0 {
+		return &clouddatabasesv5.GetTaskResponse{}, &core.DetailedResponse{}, nil
+	}
+	task := m.TaskPolls[0]
+	m.TaskPolls = m.TaskPolls[1:]
+	return &clouddatabasesv5.GetTaskResponse{Task: task}, &core.DetailedResponse{}, nil
 }
 
 func (m *MockTaskClient) ListDeploymentTasks(opts *clouddatabasesv5.ListDeploymentTasksOptions) (*clouddatabasesv5.Tasks, *core.DetailedResponse, error) {
@@ -270,6 +286,68 @@ func TestMatchingTaskInProgress(t *testing.T) {
 	}
 }
 
+func TestWaitForTaskComplete(t *testing.T) {
+	originalInterval := taskPollInterval
+	taskPollInterval = time.Millisecond
+	defer func() { taskPollInterval = originalInterval }()
+
+	mockTask := func(status string, progress int64) *clouddatabasesv5.Task {
+		return &clouddatabasesv5.Task{ID: core.StringPtr("task-1"), Status: core.StringPtr(status), ProgressPercent: core.Int64Ptr(progress)}
+	}
+
+	testcases := []struct {
+		description      string
+		taskPolls        []*clouddatabasesv5.Task
+		mockError        error
+		expectedError    string
+		expectedProgress []int64
+	}{
+		{
+			description:      "When the task completes, Expect progress for each change and no error",
+			taskPolls:        []*clouddatabasesv5.Task{mockTask(databaseTaskQueuedStatus, 0), mockTask(databaseTaskRunningStatus, 40), mockTask(databaseTaskRunningStatus, 40), mockTask(databaseTaskCompletedStatus, 100)},
+			expectedProgress: []int64{0, 40, 100},
+		},
+		{
+			description:      "When the task is no longer returned, Expect no error",
+			taskPolls:        []*clouddatabasesv5.Task{mockTask(databaseTaskRunningStatus, 10)},
+			expectedProgress: []int64{10},
+		},
+		{
+			description:      "When the task fails, Expect a failed error",
+			taskPolls:        []*clouddatabasesv5.Task{mockTask(databaseTaskRunningStatus, 10), mockTask(databaseTaskFailedStatus, 10)},
+			expectedError:    "database task task-1 failed",
+			expectedProgress: []int64{10, 10},
+		},
+		{
+			description:   "When getting the task errors, Expect an error",
+			mockError:     fmt.Errorf("API error"),
+			expectedError: "failed to get database task task-1",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.description, func(t *testing.T) {
+			tm := &TaskManager{
+				Client:     &MockTaskClient{TaskPolls: tc.taskPolls, Err: tc.mockError},
+				InstanceID: "inst-1",
+			}
+
+			var progress []int64
+			err := tm.waitForTaskComplete(context.Background(), "task-1", time.Minute, func(task *clouddatabasesv5.Task) {
+				progress = append(progress, *task.ProgressPercent)
+			})
+
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectedProgress, progress)
+		})
+	}
+}
+
 func TestIsGen2Plan(t *testing.T) {
 	cases := []struct {
 		plan string
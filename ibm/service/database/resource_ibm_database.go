@@ -184,6 +184,7 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 			validateUsersDiff,
 			validateRemoteLeaderIDDiff,
 			validateVersionDiff,
+			validateConfigurationDiff,
 			validateAsyncRestoreDiff,
 			validateBackendSpecificServiceEndpointsDiff,
 		),
@@ -285,6 +286,12 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Computed:    true,
 				Description: "The configuration schema in JSON format. Gen2: Always empty (not available).",
 			},
+			"configuration_restart_settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The settings of the most recent configuration change of the deployment whose change restarts the database. Set in the plan of the change. Gen2: Always empty (not available).",
+			},
 			"version": {
 				Description: "The database version to provision if specified or the database version to upgrade to. Classic: This field can be updated to perform an in-place upgrade without forcing the creation of a new resource. Gen2: Can be set at creation only. Updates fail with error. In-place version upgrades are not supported for Gen2 plans.",
 				Type:        schema.TypeString,
@@ -1589,6 +1596,10 @@ func classicDatabaseInstanceCreate(context context.Context, d *schema.ResourceDa
 			return diag.FromErr(fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err))
 		}
 
+		if err = validateConfigurationForDeployment(instanceID, config.(string), meta); err != nil {
+			return diag.FromErr(err)
+		}
+
 		var configuration clouddatabasesv5.ConfigurationIntf = new(clouddatabasesv5.Configuration)
 		err = core.UnmarshalModel(rawConfig, "", &configuration, clouddatabasesv5.UnmarshalConfiguration)
 		if err != nil {
@@ -2269,7 +2280,18 @@ func classicDatabaseInstanceUpdate(context context.Context, d *schema.ResourceDa
 	if d.HasChange("remote_leader_id") {
 		remoteLeaderId := d.Get("remote_leader_id").(string)
 
+		replica := true
 		if remoteLeaderId == "" {
+			replica, err = isReadOnlyReplica(context, cloudDatabasesClient, instanceID)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("[ERROR] Error promoting read replica: %s", err))
+			}
+			if !replica {
+				log.Printf("[INFO] Database (%s) has already been promoted, accepting it as a leader", instanceID)
+			}
+		}
+
+		if remoteLeaderId == "" && replica {
 			skipInitialBackup := false
 			if skip, ok := d.GetOk("skip_initial_backup"); ok {
 				skipInitialBackup = skip.(bool)
@@ -0,0 +1,543 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	validation "github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	databaseUserPasswordMinLength = 15
+	databaseUserPasswordMaxLength = 32
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.All(
+			validateDatabaseUserDiff,
+			// ops_manager users cannot be updated
+			customdiff.ForceNewIf("password", isOpsManagerUserDiff),
+			customdiff.ForceNewIf("role", isOpsManagerUserDiff),
+			customdiff.ForceNewIf("password_policy", isOpsManagerUserDiff),
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the database deployment.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 32),
+				Description:  "User name",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+				Description:  "User type",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User role. Only available for ops_manager user type and Redis 6.0 and above.",
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(databaseUserPasswordMinLength, databaseUserPasswordMaxLength),
+				Description:  "User password. If not specified, a password is generated according to password_policy. A generated password is not set when it is stored in Secrets Manager.",
+			},
+			"password_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Policy for generating the password when password is not specified. Changing the policy generates a new password.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"length": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      databaseUserPasswordMaxLength,
+							ValidateFunc: validation.IntBetween(databaseUserPasswordMinLength, databaseUserPasswordMaxLength),
+							Description:  "Length of the generated password.",
+						},
+						"special_characters": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the generated password contains the special characters allowed for the user type. Always true for ops_manager users.",
+						},
+					},
+				},
+			},
+			"secrets_manager": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Store the credentials in a Secrets Manager username_password secret.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the Secrets Manager instance.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The region of the Secrets Manager instance. Defaults to the region of the provider.",
+						},
+						"endpoint_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"public", "private"}, false),
+							Description:  "public or private.",
+						},
+						"secret_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "default",
+							Description: "The ID of the secret group to create the secret in.",
+						},
+						"secret_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The name of the secret. Defaults to the user name.",
+						},
+					},
+				},
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the Secrets Manager secret that holds the credentials.",
+			},
+			"secret_crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the Secrets Manager secret that holds the credentials.",
+			},
+		},
+	}
+}
+
+func databaseUserFromResourceData(d *schema.ResourceData) *DatabaseUser {
+	user := &DatabaseUser{
+		Username: d.Get("name").(string),
+		Type:     d.Get("type").(string),
+	}
+	if role, ok := d.GetOk("role"); ok {
+		r := role.(string)
+		user.Role = &r
+	}
+	return user
+}
+
+func isPasswordConfigured(d *schema.ResourceData) bool {
+	return !d.GetRawConfig().GetAttr("password").IsNull()
+}
+
+func isOpsManagerUserDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+	return diff.Id() != "" && diff.Get("type").(string) == "ops_manager"
+}
+
+func validateDatabaseUserDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	user := &DatabaseUser{
+		Username: diff.Get("name").(string),
+		Type:     diff.Get("type").(string),
+	}
+	if role, ok := diff.GetOk("role"); ok {
+		r := role.(string)
+		user.Role = &r
+	}
+
+	if diff.NewValueKnown("password") && !diff.GetRawConfig().GetAttr("password").IsNull() {
+		user.Password = diff.Get("password").(string)
+		if err = user.ValidatePassword(); err != nil {
+			return err
+		}
+	}
+
+	if err = user.ValidateOpsManagerRole(); err != nil {
+		return err
+	}
+
+	// The API requires the password with the role, and the password of an imported user is unknown
+	if diff.Id() != "" && user.Type != "ops_manager" && diff.HasChange("role") &&
+		!diff.HasChange("password") && !diff.HasChange("password_policy") &&
+		diff.Get("password").(string) == "" && diff.Get("secret_id").(string) == "" {
+		return databaseUserUnknownPasswordError(user.Username)
+	}
+
+	return nil
+}
+
+func databaseUserUnknownPasswordError(username string) error {
+	return fmt.Errorf("[ERROR] The role of database user (%s) cannot be changed, because its password is unknown. Set password, or change password_policy to generate a new password, together with the role", username)
+}
+
+// generateDatabaseUserPassword generates a password that passes DatabaseUser.ValidatePassword for the user type.
+func generateDatabaseUserPassword(userType string, length int, specialCharacters bool) (string, error) {
+	const (
+		lower  = "abcdefghijklmnopqrstuvwxyz"
+		upper  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+		digits = "0123456789"
+	)
+
+	specials := ""
+	if specialCharacters || userType == "ops_manager" {
+		specials = databaseUserSpecialChars
+		if userType == "ops_manager" {
+			specials = opsManagerUserSpecialChars
+		}
+	}
+
+	randomChar := func(chars string) (byte, error) {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(chars))))
+		if err != nil {
+			return 0, err
+		}
+		return chars[n.Int64()], nil
+	}
+
+	// Start with a letter, so the password does not begin with a special character
+	required := []string{lower, upper, digits}
+	if userType == "ops_manager" {
+		required = append(required, specials)
+	}
+	all := lower + upper + digits + specials
+
+	password := make([]byte, 0, length)
+	for _, chars := range required {
+		c, err := randomChar(chars)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+	for len(password) < length {
+		c, err := randomChar(all)
+		if err != nil {
+			return "", err
+		}
+		password = append(password, c)
+	}
+
+	// Shuffle everything after the leading letter
+	for i := len(password) - 1; i > 1; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i)))
+		if err != nil {
+			return "", err
+		}
+		j := int(n.Int64()) + 1
+		password[i], password[j] = password[j], password[i]
+	}
+
+	return string(password), nil
+}
+
+func generatePasswordFromPolicy(d *schema.ResourceData) (string, error) {
+	length := databaseUserPasswordMaxLength
+	specialCharacters := true
+	if policies := d.Get("password_policy").([]interface{}); len(policies) > 0 && policies[0] != nil {
+		policy := policies[0].(map[string]interface{})
+		length = policy["length"].(int)
+		specialCharacters = policy["special_characters"].(bool)
+	}
+	return generateDatabaseUserPassword(d.Get("type").(string), length, specialCharacters)
+}
+
+func getDatabaseUserSecretsManagerClient(d *schema.ResourceData, meta interface{}) (*secretsmanagerv2.SecretsManagerV2, error) {
+	config := d.Get("secrets_manager").([]interface{})[0].(map[string]interface{})
+	return secretsmanager.GetClientForInstance(meta.(conns.ClientSession), config["instance_id"].(string), config["region"].(string), config["endpoint_type"].(string))
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("deployment_id").(string)
+	user := databaseUserFromResourceData(d)
+
+	generated := !isPasswordConfigured(d)
+	if generated {
+		password, err := generatePasswordFromPolicy(d)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error generating the password of database user (%s): %s", user.Username, err))
+		}
+		user.Password = password
+	} else {
+		user.Password = d.Get("password").(string)
+	}
+
+	if err := user.Create(instanceID, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, user.Type, user.Username))
+
+	_, useSecretsManager := d.GetOk("secrets_manager")
+	if useSecretsManager {
+		secretsManagerClient, err := getDatabaseUserSecretsManagerClient(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting Secrets Manager client settings: %s", err))
+		}
+
+		config := d.Get("secrets_manager").([]interface{})[0].(map[string]interface{})
+		secretName := config["secret_name"].(string)
+		if secretName == "" {
+			secretName = user.Username
+		}
+
+		secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, &secretsmanagerv2.CreateSecretOptions{
+			SecretPrototype: &secretsmanagerv2.UsernamePasswordSecretPrototype{
+				SecretType:    core.StringPtr(secretsmanagerv2.Secret_SecretType_UsernamePassword),
+				Name:          core.StringPtr(secretName),
+				Description:   core.StringPtr(fmt.Sprintf("Credentials of the %s user %s of database %s", user.Type, user.Username, instanceID)),
+				SecretGroupID: core.StringPtr(config["secret_group_id"].(string)),
+				Username:      core.StringPtr(user.Username),
+				Password:      core.StringPtr(user.Password),
+			},
+		})
+		if err != nil {
+			// Without the secret the generated password would be lost, so the user is deleted again
+			secretErr := fmt.Errorf("[ERROR] Error storing the credentials of database user (%s) in Secrets Manager: %s\n%s", user.Username, err, response)
+			if deleteErr := user.Delete(instanceID, d, meta); deleteErr != nil {
+				// Keep the password in state, so that the user can still be used or replaced
+				d.Set("password", user.Password)
+				return diag.FromErr(fmt.Errorf("%s\nThe user could not be deleted again: %s", secretErr, deleteErr))
+			}
+			d.SetId("")
+			return diag.FromErr(secretErr)
+		}
+
+		secret := secretIntf.(*secretsmanagerv2.UsernamePasswordSecret)
+		d.Set("secret_id", secret.ID)
+		d.Set("secret_crn", secret.Crn)
+	}
+
+	// A generated password is only kept in state when it is not stored in Secrets Manager
+	if generated && useSecretsManager {
+		d.Set("password", "")
+	} else {
+		d.Set("password", user.Password)
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func parseDatabaseUserID(id string) (instanceID string, userType string, username string, err error) {
+	// The deployment CRN contains a "/" in its account segment
+	parts := strings.Split(id, "/")
+	if len(parts) < 3 {
+		return "", "", "", fmt.Errorf("[ERROR] Wrong format of resource ID. To import a database user use the format `<deployment_id>/<type>/<name>`")
+	}
+	return strings.Join(parts[:len(parts)-2], "/"), parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, userType, username, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentInfo, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, &clouddatabasesv5.GetDeploymentInfoOptions{
+		ID: core.StringPtr(instanceID),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database (%s) of user (%s) not found, removing the user from state", instanceID, username)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s): %s\n%s", instanceID, err, response))
+	}
+
+	// ICD does not implement a GetUser API, the connection of the user is only found while the user exists
+	endpointType := clouddatabasesv5.GetConnectionOptionsEndpointTypePublicConst
+	if deployment := deploymentInfo.Deployment; deployment != nil && deployment.EnablePublicEndpoints != nil && !*deployment.EnablePublicEndpoints {
+		endpointType = clouddatabasesv5.GetConnectionOptionsEndpointTypePrivateConst
+	}
+	_, response, err = cloudDatabasesClient.GetConnectionWithContext(context, &clouddatabasesv5.GetConnectionOptions{
+		ID:           core.StringPtr(instanceID),
+		UserType:     core.StringPtr(userType),
+		UserID:       core.StringPtr(username),
+		EndpointType: core.StringPtr(endpointType),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Database user (%s) not found, removing it from state", username)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database user (%s): %s\n%s", username, err, response))
+	}
+
+	d.Set("deployment_id", instanceID)
+	d.Set("type", userType)
+	d.Set("name", username)
+
+	if secretID, ok := d.GetOk("secret_id"); ok {
+		secretsManagerClient, err := getDatabaseUserSecretsManagerClient(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting Secrets Manager client settings: %s", err))
+		}
+		_, response, err := secretsManagerClient.GetSecretMetadataWithContext(context, &secretsmanagerv2.GetSecretMetadataOptions{
+			ID: core.StringPtr(secretID.(string)),
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				log.Printf("[WARN] Secret (%s) of database user (%s) not found", secretID, username)
+				d.Set("secret_id", "")
+				d.Set("secret_crn", "")
+				return nil
+			}
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting secret (%s) of database user (%s): %s\n%s", secretID, username, err, response))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("deployment_id").(string)
+	user := databaseUserFromResourceData(d)
+	secretID := d.Get("secret_id").(string)
+
+	generated := !isPasswordConfigured(d)
+	newPassword := ""
+	if !generated && d.HasChange("password") {
+		newPassword = d.Get("password").(string)
+	} else if generated && d.HasChange("password_policy") {
+		password, err := generatePasswordFromPolicy(d)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error generating the password of database user (%s): %s", user.Username, err))
+		}
+		newPassword = password
+	}
+
+	if newPassword == "" && !d.HasChange("role") {
+		return resourceIBMDatabaseUserRead(context, d, meta)
+	}
+
+	var secretsManagerClient *secretsmanagerv2.SecretsManagerV2
+	if secretID != "" {
+		client, err := getDatabaseUserSecretsManagerClient(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting Secrets Manager client settings: %s", err))
+		}
+		secretsManagerClient = client
+	}
+
+	switch {
+	case newPassword != "":
+		user.Password = newPassword
+	case d.Get("password").(string) != "":
+		user.Password = d.Get("password").(string)
+	case secretsManagerClient != nil:
+		// The password of the user is only stored in Secrets Manager
+		secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, &secretsmanagerv2.GetSecretOptions{
+			ID: core.StringPtr(secretID),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting secret (%s) of database user (%s): %s\n%s", secretID, user.Username, err, response))
+		}
+		user.Password = *secretIntf.(*secretsmanagerv2.UsernamePasswordSecret).Password
+	default:
+		return diag.FromErr(databaseUserUnknownPasswordError(user.Username))
+	}
+
+	if err := user.Update(instanceID, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if newPassword != "" && secretsManagerClient != nil {
+		_, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, &secretsmanagerv2.CreateSecretVersionOptions{
+			SecretID: core.StringPtr(secretID),
+			SecretVersionPrototype: &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{
+				Password: core.StringPtr(newPassword),
+			},
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error storing the new password of database user (%s) in secret (%s): %s\n%s", user.Username, secretID, err, response))
+		}
+	}
+
+	if generated && newPassword != "" && secretsManagerClient == nil {
+		d.Set("password", newPassword)
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, userType, username, err := parseDatabaseUserID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	user := &DatabaseUser{
+		Username: username,
+		Type:     userType,
+	}
+	if err := user.Delete(instanceID, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if secretID := d.Get("secret_id").(string); secretID != "" {
+		secretsManagerClient, err := getDatabaseUserSecretsManagerClient(d, meta)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting Secrets Manager client settings: %s", err))
+		}
+		response, err := secretsManagerClient.DeleteSecretWithContext(context, &secretsmanagerv2.DeleteSecretOptions{
+			ID: core.StringPtr(secretID),
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error deleting secret (%s) of database user (%s): %s\n%s", secretID, username, err, response))
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
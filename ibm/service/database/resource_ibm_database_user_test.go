@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseUserBasic(t *testing.T) {
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	userResource := "ibm_database_user.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acc.ConfigCompose(
+					testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup, serviceName),
+					testAccCheckIBMDatabaseUserConfig(serviceName, 20)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userResource, "name", "tf_app_user"),
+					resource.TestCheckResourceAttr(userResource, "type", "database"),
+					resource.TestCheckResourceAttrSet(userResource, "password"),
+				),
+			},
+			{
+				Config: acc.ConfigCompose(
+					testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup, serviceName),
+					testAccCheckIBMDatabaseUserConfig(serviceName, 24)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userResource, "password_policy.0.length", "24"),
+					resource.TestCheckResourceAttrSet(userResource, "password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMDatabaseUserSecretsManager(t *testing.T) {
+	databaseResourceGroup := "default"
+	serviceName := fmt.Sprintf("tf-Pgress-%d", acctest.RandIntRange(10, 100))
+	userResource := "ibm_database_user.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: acc.ConfigCompose(
+					testAccCheckIBMDatabaseInstancePostgresMinimal(databaseResourceGroup, serviceName),
+					testAccCheckIBMDatabaseUserSecretsManagerConfig(serviceName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(userResource, "password", ""),
+					resource.TestCheckResourceAttrSet(userResource, "secret_id"),
+					resource.TestCheckResourceAttrSet(userResource, "secret_crn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseUserConfig(serviceName string, length int) string {
+	return fmt.Sprintf(`
+	resource "ibm_database_user" "user" {
+		deployment_id = ibm_database.%[1]s.id
+		name          = "tf_app_user"
+
+		password_policy {
+			length = %[2]d
+		}
+	}
+	`, serviceName, length)
+}
+
+func testAccCheckIBMDatabaseUserSecretsManagerConfig(serviceName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_database_user" "user" {
+		deployment_id = ibm_database.%[1]s.id
+		name          = "tf_app_user"
+
+		secrets_manager {
+			instance_id = "%[2]s"
+			region      = "%[3]s"
+			secret_name = "%[1]s-tf-app-user"
+		}
+	}
+	`, serviceName, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,50 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDatabaseUserPassword(t *testing.T) {
+	testcases := []struct {
+		userType          string
+		length            int
+		specialCharacters bool
+	}{
+		{userType: "database", length: 32, specialCharacters: true},
+		{userType: "database", length: 15, specialCharacters: false},
+		{userType: "read_only_replica", length: 20, specialCharacters: true},
+		{userType: "ops_manager", length: 15, specialCharacters: false},
+	}
+
+	for _, tc := range testcases {
+		for i := 0; i < 50; i++ {
+			password, err := generateDatabaseUserPassword(tc.userType, tc.length, tc.specialCharacters)
+			require.NoError(t, err)
+			require.Len(t, password, tc.length)
+
+			user := &DatabaseUser{Username: "user", Type: tc.userType, Password: password}
+			require.NoError(t, user.ValidatePassword())
+
+			if tc.userType != "ops_manager" && !tc.specialCharacters {
+				require.False(t, strings.ContainsAny(password, databaseUserSpecialChars), password)
+			}
+		}
+	}
+}
+
+func TestParseDatabaseUserID(t *testing.T) {
+	instanceID, userType, username, err := parseDatabaseUserID("crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1e2f3::/database/app_user")
+	require.NoError(t, err)
+	require.Equal(t, "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/abc123:d1e2f3::", instanceID)
+	require.Equal(t, "database", userType)
+	require.Equal(t, "app_user", username)
+
+	_, _, _, err = parseDatabaseUserID("app_user")
+	require.Error(t, err)
+}
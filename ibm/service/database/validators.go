@@ -5,9 +5,17 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -153,6 +161,232 @@ func validateUpgradeVersion(instanceId string, location string, oldVersion strin
 
 /* VERSION VALIDATOR END */
 
+/* CONFIGURATION VALIDATOR */
+
+type ConfigurationSchemaProperty struct {
+	Type            string        `json:"type"`
+	Enum            []interface{} `json:"enum"`
+	Minimum         *float64      `json:"minimum"`
+	Maximum         *float64      `json:"maximum"`
+	RequiresRestart bool          `json:"requires_restart"`
+}
+
+type ConfigurationSchema map[string]ConfigurationSchemaProperty
+
+// configurationSchemaCache holds the configuration schemas fetched during this run, keyed by service and version.
+var configurationSchemaCache = struct {
+	sync.Mutex
+	schemas map[string]ConfigurationSchema
+}{schemas: map[string]ConfigurationSchema{}}
+
+var fetchConfigurationSchemaFn = fetchConfigurationSchema
+
+func fetchConfigurationSchema(instanceId string, meta interface{}) (ConfigurationSchema, error) {
+	icdClient, err := meta.(conns.ClientSession).ICDAPI()
+	if err != nil {
+		return nil, err
+	}
+
+	configSchema, err := icdClient.Configurations().GetConfiguration(flex.EscapeUrlParm(instanceId))
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(configSchema)
+	if err != nil {
+		return nil, err
+	}
+
+	return expandConfigurationSchema(b)
+}
+
+func expandConfigurationSchema(b []byte) (ConfigurationSchema, error) {
+	var response struct {
+		Schema ConfigurationSchema `json:"schema"`
+	}
+	if err := json.Unmarshal(b, &response); err != nil {
+		return nil, err
+	}
+	return response.Schema, nil
+}
+
+// getConfigurationSchema returns the configuration schema of the deployment instanceId, fetching it when it
+// is not cached. The schema can only be fetched for an existing deployment, so nil is returned without one,
+// even when the schema of the same service and version is cached, to keep the result independent of the
+// order in which resources are planned.
+func getConfigurationSchema(instanceId string, service string, version string, meta interface{}) (ConfigurationSchema, error) {
+	if instanceId == "" {
+		return nil, nil
+	}
+
+	key := fmt.Sprintf("%s:%s", service, version)
+
+	configurationSchemaCache.Lock()
+	defer configurationSchemaCache.Unlock()
+
+	if configSchema, ok := configurationSchemaCache.schemas[key]; ok {
+		return configSchema, nil
+	}
+
+	configSchema, err := fetchConfigurationSchemaFn(instanceId, meta)
+	if err != nil {
+		return nil, err
+	}
+	configurationSchemaCache.schemas[key] = configSchema
+
+	return configSchema, nil
+}
+
+func (p ConfigurationSchemaProperty) validate(value interface{}) error {
+	switch p.Type {
+	case "integer", "number":
+		number, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("must be of type %s, got %v", p.Type, value)
+		}
+		if p.Type == "integer" && number != math.Trunc(number) {
+			return fmt.Errorf("must be an integer, got %v", value)
+		}
+		if p.Minimum != nil && number < *p.Minimum {
+			return fmt.Errorf("must be at least %v, got %v", *p.Minimum, value)
+		}
+		if p.Maximum != nil && number > *p.Maximum {
+			return fmt.Errorf("must be at most %v, got %v", *p.Maximum, value)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("must be a string, got %v", value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("must be a boolean, got %v", value)
+		}
+	}
+
+	if len(p.Enum) != 0 {
+		for _, allowed := range p.Enum {
+			if reflect.DeepEqual(allowed, value) {
+				return nil
+			}
+		}
+		return fmt.Errorf("must be one of %v, got %v", p.Enum, value)
+	}
+
+	return nil
+}
+
+// validateConfiguration checks every key of the configuration against the schema and returns
+// the keys whose change requires a database restart.
+func validateConfiguration(configuration map[string]interface{}, configSchema ConfigurationSchema) (restartKeys []string, err error) {
+	keys := make([]string, 0, len(configuration))
+	for key := range configuration {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs []string
+	for _, key := range keys {
+		property, ok := configSchema[key]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("%s: is not a configurable setting", key))
+			continue
+		}
+		if err := property.validate(configuration[key]); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", key, err))
+			continue
+		}
+		if property.RequiresRestart {
+			restartKeys = append(restartKeys, key)
+		}
+	}
+
+	if len(errs) != 0 {
+		return restartKeys, fmt.Errorf("configuration is invalid:\n  %s", strings.Join(errs, "\n  "))
+	}
+
+	return restartKeys, nil
+}
+
+// validateConfigurationDiff validates a configuration change of an existing deployment against its
+// configuration schema, and sets configuration_restart_settings to the changed settings that restart the
+// database. The configuration of a new deployment is validated by validateConfigurationForDeployment once
+// the deployment exists.
+func validateConfigurationDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) (err error) {
+	configJSON, ok := diff.GetOk("configuration")
+	if !ok || !diff.HasChange("configuration") || diff.Id() == "" || isGen2Plan(diff.Get("plan").(string)) {
+		return nil
+	}
+
+	var configuration map[string]interface{}
+	if err = json.Unmarshal([]byte(configJSON.(string)), &configuration); err != nil {
+		// Reported by resourceIBMDatabaseInstanceDiff
+		return nil
+	}
+
+	// The schema of the current deployment does not apply to a version it is being upgraded to
+	if diff.HasChange("version") {
+		return diff.SetNewComputed("configuration_restart_settings")
+	}
+
+	instanceId := diff.Id()
+	configSchema, err := getConfigurationSchema(instanceId, diff.Get("service").(string), diff.Get("version").(string), meta)
+	if err != nil {
+		log.Printf("[WARN] Unable to fetch the configuration schema of database (%s), skipping configuration validation: %s", instanceId, err)
+		return diff.SetNewComputed("configuration_restart_settings")
+	}
+	if configSchema == nil {
+		return diff.SetNewComputed("configuration_restart_settings")
+	}
+
+	restartKeys, err := validateConfiguration(configuration, configSchema)
+	if err != nil {
+		return fmt.Errorf("[ERROR] %s", err)
+	}
+
+	oldConfigJSON, _ := diff.GetChange("configuration")
+	var oldConfiguration map[string]interface{}
+	json.Unmarshal([]byte(oldConfigJSON.(string)), &oldConfiguration)
+	restartKeys = changedConfigurationKeys(restartKeys, oldConfiguration, configuration)
+
+	return diff.SetNew("configuration_restart_settings", restartKeys)
+}
+
+// validateConfigurationForDeployment validates the configuration of a new deployment against its
+// configuration schema before the configuration is applied.
+func validateConfigurationForDeployment(instanceId string, configJSON string, meta interface{}) error {
+	var configuration map[string]interface{}
+	if err := json.Unmarshal([]byte(configJSON), &configuration); err != nil {
+		return fmt.Errorf("[ERROR] configuration JSON invalid\n%s", err)
+	}
+
+	// The version of a new deployment is not known when it is not configured, so the cache is not used
+	configSchema, err := fetchConfigurationSchemaFn(instanceId, meta)
+	if err != nil {
+		log.Printf("[WARN] Unable to fetch the configuration schema of database (%s), skipping configuration validation: %s", instanceId, err)
+		return nil
+	}
+	if configSchema == nil {
+		return nil
+	}
+
+	if _, err := validateConfiguration(configuration, configSchema); err != nil {
+		return fmt.Errorf("[ERROR] %s", err)
+	}
+	return nil
+}
+
+func changedConfigurationKeys(keys []string, oldConfiguration, newConfiguration map[string]interface{}) []string {
+	var changed []string
+	for _, key := range keys {
+		if oldValue, ok := oldConfiguration[key]; !ok || !reflect.DeepEqual(oldValue, newConfiguration[key]) {
+			changed = append(changed, key)
+		}
+	}
+	return changed
+}
+
+/* CONFIGURATION VALIDATOR END */
+
 func validateUnsupportedAttrsDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	return pickResourceBackendFromDiff(d).ValidateUnsupportedAttrsDiff(ctx, d, meta)
 }
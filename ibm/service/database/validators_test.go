@@ -4,6 +4,7 @@
 package database
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
@@ -517,3 +518,129 @@ func TestValidateGroupScaling(t *testing.T) {
 		})
 	}
 }
+
+func TestExpandConfigurationSchema(t *testing.T) {
+	configSchema, err := expandConfigurationSchema([]byte(`{"schema": {
+		"max_connections": {"type": "integer", "minimum": 115, "maximum": 5000, "requires_restart": true},
+		"log_connections": {"type": "string", "enum": ["off", "on"]}
+	}}`))
+	require.NoError(t, err)
+	require.Len(t, configSchema, 2)
+	require.True(t, configSchema["max_connections"].RequiresRestart)
+	require.Equal(t, float64(115), *configSchema["max_connections"].Minimum)
+	require.Equal(t, []interface{}{"off", "on"}, configSchema["log_connections"].Enum)
+}
+
+func TestValidateConfiguration(t *testing.T) {
+	minConnections, maxConnections := float64(115), float64(5000)
+	configSchema := ConfigurationSchema{
+		"max_connections":      {Type: "integer", Minimum: &minConnections, Maximum: &maxConnections, RequiresRestart: true},
+		"log_connections":      {Type: "string", Enum: []interface{}{"off", "on"}},
+		"archive_timeout":      {Type: "integer"},
+		"maxmemory-policy":     {Type: "string", RequiresRestart: true},
+		"stop-writes-on-error": {Type: "boolean"},
+	}
+
+	tests := []struct {
+		description         string
+		configuration       string
+		expectedRestartKeys []string
+		expectedErrors      []string
+	}{
+		{
+			description:         "When the configuration is valid, Expect the keys requiring a restart",
+			configuration:       `{"max_connections": 200, "log_connections": "on", "maxmemory-policy": "allkeys-lru", "stop-writes-on-error": true}`,
+			expectedRestartKeys: []string{"max_connections", "maxmemory-policy"},
+		},
+		{
+			description:    "When a key is not in the schema, Expect a not configurable error",
+			configuration:  `{"max_conections": 200}`,
+			expectedErrors: []string{"max_conections: is not a configurable setting"},
+		},
+		{
+			description:    "When a value is out of range, Expect a range error",
+			configuration:  `{"max_connections": 10000}`,
+			expectedErrors: []string{"max_connections: must be at most 5000, got 10000"},
+		},
+		{
+			description:    "When values have the wrong type, Expect an error for each key",
+			configuration:  `{"archive_timeout": 1.5, "log_connections": "yes", "max_connections": "200", "stop-writes-on-error": "true"}`,
+			expectedErrors: []string{"archive_timeout: must be an integer", "log_connections: must be one of [off on]", "max_connections: must be of type integer", "stop-writes-on-error: must be a boolean"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			var configuration map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(tc.configuration), &configuration))
+
+			restartKeys, err := validateConfiguration(configuration, configSchema)
+
+			if len(tc.expectedErrors) != 0 {
+				require.Error(t, err)
+				for _, expectedError := range tc.expectedErrors {
+					require.Contains(t, err.Error(), expectedError)
+				}
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectedRestartKeys, restartKeys)
+			}
+		})
+	}
+}
+
+func TestGetConfigurationSchemaCache(t *testing.T) {
+	originalFetchFunc := fetchConfigurationSchemaFn
+	defer func() { fetchConfigurationSchemaFn = originalFetchFunc }()
+
+	fetches := 0
+	fetchConfigurationSchemaFn = func(instanceID string, meta interface{}) (ConfigurationSchema, error) {
+		fetches++
+		return ConfigurationSchema{"max_connections": {Type: "integer"}}, nil
+	}
+
+	configSchema, err := getConfigurationSchema("", "databases-for-postgresql", "cache-test", &MockMeta{})
+	require.NoError(t, err)
+	require.Nil(t, configSchema)
+
+	for i := 0; i < 2; i++ {
+		configSchema, err = getConfigurationSchema("test-instance", "databases-for-postgresql", "cache-test", &MockMeta{})
+		require.NoError(t, err)
+		require.Contains(t, configSchema, "max_connections")
+	}
+
+	// Without a deployment the cached schema is not used, whatever was planned before
+	configSchema, err = getConfigurationSchema("", "databases-for-postgresql", "cache-test", &MockMeta{})
+	require.NoError(t, err)
+	require.Nil(t, configSchema)
+	require.Equal(t, 1, fetches)
+}
+
+func TestValidateConfigurationForDeployment(t *testing.T) {
+	originalFetchFunc := fetchConfigurationSchemaFn
+	defer func() { fetchConfigurationSchemaFn = originalFetchFunc }()
+
+	maximum := float64(500)
+	fetchConfigurationSchemaFn = func(instanceID string, meta interface{}) (ConfigurationSchema, error) {
+		require.Equal(t, "new-instance", instanceID)
+		return ConfigurationSchema{"max_connections": {Type: "integer", Maximum: &maximum}}, nil
+	}
+
+	require.NoError(t, validateConfigurationForDeployment("new-instance", `{"max_connections": 200}`, &MockMeta{}))
+
+	err := validateConfigurationForDeployment("new-instance", `{"max_connections": 1000}`, &MockMeta{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max_connections: must be at most 500")
+
+	err = validateConfigurationForDeployment("new-instance", `{"unknown_setting": 1}`, &MockMeta{})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "unknown_setting: is not a configurable setting")
+}
+
+func TestChangedConfigurationKeys(t *testing.T) {
+	oldConfiguration := map[string]interface{}{"max_connections": float64(200), "shared_buffers": float64(1024)}
+	newConfiguration := map[string]interface{}{"max_connections": float64(300), "shared_buffers": float64(1024), "wal_level": "logical"}
+
+	changed := changedConfigurationKeys([]string{"max_connections", "shared_buffers", "wal_level"}, oldConfiguration, newConfiguration)
+	require.Equal(t, []string{"max_connections", "wal_level"}, changed)
+}
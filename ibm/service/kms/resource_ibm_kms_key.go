@@ -5,20 +5,71 @@ package kms
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"log"
 	"net/url"
+	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kms/secureimport"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	kp "github.com/IBM/keyprotect-go-client"
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+const (
+	kmsKeyStateActive        = "active"
+	kmsKeyStateSuspended     = "suspended"
+	kmsKeyStatePreActivation = "pre_activation"
+	kmsKeyStateDeactivated   = "deactivated"
+	kmsKeyStateDestroyed     = "destroyed"
+
+	// Reason code of the conflict returned when a key with a dual authorization
+	// delete policy is deleted without being set for deletion by another user
+	kmsDualAuthNotMet = "AUTHORIZATIONS_NOT_MET"
+)
+
+var kmsKeyStateNames = map[kp.KeyState]string{
+	// The client has no constant for the pre-activation state 0
+	kp.KeyState(0): kmsKeyStatePreActivation,
+	kp.Active:      kmsKeyStateActive,
+	kp.Suspended:   kmsKeyStateSuspended,
+	kp.Deactivated: kmsKeyStateDeactivated,
+	kp.Destroyed:   kmsKeyStateDestroyed,
+}
+
+// suppressKMSKeyStateDiff suppresses a change of state for a key in a state
+// that cannot be changed, a key whose activation date is not reached yet or
+// an expired key. Only active and suspended keys can be enabled or disabled.
+func suppressKMSKeyStateDiff(k, old, new string, d *schema.ResourceData) bool {
+	return old == kmsKeyStatePreActivation || old == kmsKeyStateDeactivated
+}
+
+// isKmsDualAuthDeleteConflict reports whether err is the conflict returned
+// when a key with a dual authorization delete policy is deleted before it is
+// set for deletion.
+func isKmsDualAuthDeleteConflict(err error) bool {
+	kpError, ok := err.(*kp.Error)
+	if !ok || kpError.StatusCode != 409 {
+		return false
+	}
+	for _, reason := range kpError.Reasons {
+		if strings.HasPrefix(reason.Code, kmsDualAuthNotMet) {
+			return true
+		}
+	}
+	return false
+}
+
 func suppressKMSInstanceIDDiff(k, old, new string, d *schema.ResourceData) bool {
 	// TF currently uses GUID. So just check when instance crn is passed as input it has same GUID in it.
 	return old == getInstanceIDFromCRN(new)
@@ -49,6 +100,10 @@ func ResourceIBMKmskey() *schema.Resource {
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: customdiff.All(
+			resourceIBMKmsKeyMaterialFileCustomizeDiff,
+			resourceIBMKmsKeyStateCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -66,9 +121,12 @@ func ResourceIBMKmskey() *schema.Resource {
 				Description: "Key Ring for the Key",
 			},
 			"key_id": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "Key ID",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"payload", "encrypted_nonce", "iv_value", "secure_import"},
+				Description:   "Key ID. Set it to restore a deleted key with this ID instead of creating a new key. Only keys that were generated by the service can be restored",
 			},
 			"key_name": {
 				Type:        schema.TypeString,
@@ -120,6 +178,71 @@ func ResourceIBMKmskey() *schema.Resource {
 				ForceNew:    true,
 				Description: "Only for imported root key",
 			},
+			"secure_import": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"payload", "encrypted_nonce", "iv_value"},
+				Description:   "Import the root key from key_material_file or key_material_wo with an import token. The provider wraps the key material and encrypts the nonce itself",
+			},
+			"key_material_file": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"key_material_wo"},
+				Description:   "Path to a file that contains the base64 encoded key material of the root key to import securely",
+			},
+			"key_material_file_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 hash of the contents of key_material_file. A change of the contents imports the new key material as a new key",
+			},
+			"key_material_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"key_material_file"},
+				Description:   "The base64 encoded key material of the root key to import securely. This value is not stored in the state",
+			},
+			"key_material_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"key_material_wo"},
+				Description:  "Version of key_material_wo. Changing it imports the new key material as a new key",
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validate.ValidateAllowedStringValues([]string{kmsKeyStateActive, kmsKeyStateSuspended}),
+				DiffSuppressFunc: suppressKMSKeyStateDiff,
+				Description:      "The state of the key, active or suspended. A suspended key is disabled and cannot be used for key operations. The state of a key in pre_activation or deactivated state cannot be changed",
+			},
+			"rotation_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any value. Changing it rotates the root key",
+			},
+			"rotation_key_material_wo": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "The base64 encoded new key material for the rotation of an imported root key. This value is not stored in the state",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time that the key was last rotated",
+			},
+			"dual_auth_delete_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the key has a dual authorization delete policy",
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -205,13 +328,45 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	if err != nil {
 		return err
 	}
-	kpAPI, _, err := populateKPClient(d, meta, instanceID)
+	kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
 	if err != nil {
 		return err
 	}
 
 	kpAPI.Config.KeyRing = d.Get("key_ring_id").(string)
 
+	if keyID, ok := d.GetOk("key_id"); ok {
+		return restoreKmsKey(d, meta, kpAPI, keyID.(string))
+	}
+
+	if d.Get("secure_import").(bool) {
+		// The import token of an instance is replaced when a new one is created
+		conns.IbmMutexKV.Lock(instanceID)
+		defer conns.IbmMutexKV.Unlock(instanceID)
+
+		if d.Get("standard_key").(bool) {
+			return flex.FmtErrorf("[ERROR] secure_import is only supported for root keys, standard_key must be false")
+		}
+		keyMaterial, err := getSecureImportKeyMaterial(d)
+		if err != nil {
+			return err
+		}
+		if _, ok := d.GetOk("key_material_file"); ok {
+			decoded, err := secureimport.DecodeKeyMaterial(keyMaterial)
+			if err != nil {
+				return flex.FmtErrorf("[ERROR] Invalid key material for secure import: %s", err)
+			}
+			d.Set("key_material_file_hash", kmsKeyMaterialHash(decoded))
+		}
+		importRequest, err := prepareSecureImport(kpAPI, instanceCRN, keyMaterial)
+		if err != nil {
+			return err
+		}
+		keyData.Payload = importRequest.Payload
+		keyData.EncryptedNonce = importRequest.EncryptedNonce
+		keyData.IV = importRequest.IV
+	}
+
 	key, err := kpAPI.CreateKeyWithOptions(context.Background(), keyData.Name, keyData.Extractable,
 		kp.WithExpiration(keyData.Expiration),
 		kp.WithPayload(keyData.Payload, &keyData.EncryptedNonce, &keyData.IV, false),
@@ -224,6 +379,161 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 	return resourceIBMKmsKeyUpdate(d, meta)
 }
 
+// Wrap the key material of a secure import with a new import token of the instance
+func prepareSecureImport(kpAPI *kp.Client, instanceCRN *string, encodedKeyMaterial string) (*secureimport.ImportRequest, error) {
+	keyMaterial, err := secureimport.DecodeKeyMaterial(encodedKeyMaterial)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] Invalid key material for secure import: %s", err)
+	}
+
+	ctx := context.Background()
+	// A short lived token that can be retrieved only once, by this import
+	_, err = kpAPI.CreateImportToken(ctx, 600, 1)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] Error while creating import token: %s", err)
+	}
+	transportKey, err := kpAPI.GetImportTokenTransportKey(ctx)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] Error while retrieving import token: %s", err)
+	}
+
+	// Hyper Protect Crypto Services only supports CBC encryption of the nonce
+	nonceMode := secureimport.NonceModeGCM
+	if instanceCRN != nil && strings.Contains(*instanceCRN, ":hs-crypto:") {
+		nonceMode = secureimport.NonceModeCBC
+	}
+	importRequest, err := secureimport.Prepare(keyMaterial, secureimport.TransportKey{
+		Payload: transportKey.Payload,
+		Nonce:   transportKey.Nonce,
+	}, nonceMode)
+	if err != nil {
+		return nil, flex.FmtErrorf("[ERROR] Error while preparing secure import: %s", err)
+	}
+	return importRequest, nil
+}
+
+// Get the base64 encoded key material of a secure import from key_material_file or key_material_wo
+func getSecureImportKeyMaterial(d *schema.ResourceData) (string, error) {
+	if path, ok := d.GetOk("key_material_file"); ok {
+		keyMaterial, err := os.ReadFile(path.(string))
+		if err != nil {
+			return "", flex.FmtErrorf("[ERROR] Error reading key_material_file: %s", err)
+		}
+		return string(keyMaterial), nil
+	}
+	keyMaterial, diags := d.GetRawConfigAt(cty.GetAttrPath("key_material_wo"))
+	if diags.HasError() {
+		return "", flex.FmtErrorf("[ERROR] Error reading key_material_wo: %v", diags)
+	}
+	if keyMaterial.IsNull() || !keyMaterial.IsKnown() || keyMaterial.AsString() == "" {
+		return "", flex.FmtErrorf("[ERROR] secure_import requires key_material_file or key_material_wo")
+	}
+	return keyMaterial.AsString(), nil
+}
+
+// Force a new key when the contents of key_material_file changed since the import.
+// A missing file is ignored, so that the key material can be removed after the import
+func resourceIBMKmsKeyMaterialFileCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	oldHash := d.Get("key_material_file_hash").(string)
+	path := d.Get("key_material_file").(string)
+	if d.Id() == "" || oldHash == "" || path == "" || d.HasChange("key_material_file") || !d.NewValueKnown("key_material_file") {
+		return nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("[DEBUG] Not checking key_material_file for changes: %s", err)
+		return nil
+	}
+	// Hash the decoded key, so that a change of the encoding, like a trailing newline, is not a change
+	keyMaterial, err := secureimport.DecodeKeyMaterial(string(content))
+	if err != nil {
+		return fmt.Errorf("[ERROR] Invalid key material in key_material_file (%s): %s", path, err)
+	}
+	if hash := kmsKeyMaterialHash(keyMaterial); hash != oldHash {
+		if err := d.SetNew("key_material_file_hash", hash); err != nil {
+			return err
+		}
+		return d.ForceNew("key_material_file_hash")
+	}
+	return nil
+}
+
+// Standard keys cannot be suspended
+func resourceIBMKmsKeyStateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("standard_key").(bool) && d.Get("state").(string) == kmsKeyStateSuspended {
+		return fmt.Errorf("[ERROR] state %q is only supported for root keys, standard_key must be false", kmsKeyStateSuspended)
+	}
+	return nil
+}
+
+func kmsKeyMaterialHash(keyMaterial []byte) string {
+	hash := sha256.Sum256(keyMaterial)
+	return hex.EncodeToString(hash[:])
+}
+
+// Restore a soft-deleted key instead of creating a new one
+func restoreKmsKey(d *schema.ResourceData, meta interface{}, kpAPI *kp.Client, keyID string) error {
+	key, err := kpAPI.GetKey(context.Background(), keyID)
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while retrieving key %s to restore: %s", keyID, err)
+	}
+	if key.State != int(kp.Destroyed) {
+		return flex.FmtErrorf("[ERROR] Key %s is not deleted and cannot be restored. Use terraform import to manage an existing key", keyID)
+	}
+	if key.Name != d.Get("key_name").(string) {
+		return flex.FmtErrorf("[ERROR] key_name %q does not match the name %q of the deleted key %s", d.Get("key_name").(string), key.Name, keyID)
+	}
+	// The restore of an imported key requires its key material, which the client cannot send
+	if key.Imported {
+		return flex.FmtErrorf("[ERROR] Key %s was imported and cannot be restored by the provider. Restore it with its key material in the Key Protect console or CLI, then use terraform import to manage it", keyID)
+	}
+
+	key, err = kpAPI.RestoreKey(context.Background(), keyID)
+	if err != nil {
+		return flex.FmtErrorf("[ERROR] Error while restoring key %s: %s", keyID, err)
+	}
+
+	d.SetId(key.CRN)
+	return resourceIBMKmsKeyUpdate(d, meta)
+}
+
+// Rotate a root key, with new key material for imported keys
+func rotateKmsKey(d *schema.ResourceData, kpAPI *kp.Client, instanceID string, instanceCRN *string, keyID string) error {
+	keyMaterial, diags := d.GetRawConfigAt(cty.GetAttrPath("rotation_key_material_wo"))
+	if diags.HasError() {
+		return flex.FmtErrorf("[ERROR] Error reading rotation_key_material_wo: %v", diags)
+	}
+	hasKeyMaterial := !keyMaterial.IsNull() && keyMaterial.IsKnown() && keyMaterial.AsString() != ""
+
+	if !d.Get("secure_import").(bool) {
+		payload := ""
+		if hasKeyMaterial {
+			payload = keyMaterial.AsString()
+		}
+		if err := kpAPI.Rotate(context.Background(), keyID, payload); err != nil {
+			return flex.FmtErrorf("[ERROR] Error while rotating key: %s", err)
+		}
+		return nil
+	}
+
+	if !hasKeyMaterial {
+		return flex.FmtErrorf("[ERROR] Rotating a securely imported key requires rotation_key_material_wo")
+	}
+
+	conns.IbmMutexKV.Lock(instanceID)
+	defer conns.IbmMutexKV.Unlock(instanceID)
+
+	importRequest, err := prepareSecureImport(kpAPI, instanceCRN, keyMaterial.AsString())
+	if err != nil {
+		return err
+	}
+	newKey := kp.NewKeyPayload(importRequest.Payload, importRequest.EncryptedNonce, importRequest.IV).WithRSA256()
+	if err := kpAPI.RotateV2(context.Background(), keyID, &newKey); err != nil {
+		return flex.FmtErrorf("[ERROR] Error while rotating key: %s", err)
+	}
+	return nil
+}
+
 func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	_, err := populateSchemaData(d, meta)
@@ -236,6 +546,48 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+
+	// Keys are created active, so only a suspended state needs to be applied to a new key
+	stateChanged := d.HasChange("state") && (!d.IsNewResource() || d.Get("state").(string) == kmsKeyStateSuspended)
+	rotate := d.HasChange("rotation_trigger") && !d.IsNewResource()
+	if stateChanged || rotate {
+		_, instanceID, keyID := getInstanceAndKeyDataFromCRN(d.Id())
+		kpAPI, instanceCRN, err := populateKPClient(d, meta, instanceID)
+		if err != nil {
+			return err
+		}
+
+		// On failure, the previous values are kept in the state so that the
+		// next plan retries the change instead of showing no diff
+		oldState, _ := d.GetChange("state")
+		oldRotationTrigger, _ := d.GetChange("rotation_trigger")
+
+		// A suspended key cannot be rotated, so it is enabled first and disabled last
+		if stateChanged && d.Get("state").(string) == kmsKeyStateActive {
+			if err := kpAPI.EnableKey(context.Background(), keyID); err != nil {
+				d.Set("state", oldState)
+				if rotate {
+					d.Set("rotation_trigger", oldRotationTrigger)
+				}
+				return flex.FmtErrorf("[ERROR] Error while enabling key: %s", err)
+			}
+		}
+		if rotate {
+			if err := rotateKmsKey(d, kpAPI, instanceID, instanceCRN, keyID); err != nil {
+				d.Set("rotation_trigger", oldRotationTrigger)
+				if stateChanged && d.Get("state").(string) == kmsKeyStateSuspended {
+					d.Set("state", oldState)
+				}
+				return err
+			}
+		}
+		if stateChanged && d.Get("state").(string) == kmsKeyStateSuspended {
+			if err := kpAPI.DisableKey(context.Background(), keyID); err != nil {
+				d.Set("state", oldState)
+				return flex.FmtErrorf("[ERROR] Error while disabling key: %s", err)
+			}
+		}
+	}
 	return resourceIBMKmsKeyRead(d, meta)
 
 }
@@ -253,6 +605,15 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	_, err1 := kpAPI.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
+	// Not part of the schema of ibm_kms_key_with_policy_overrides, which shares this function
+	dualAuthDelete, _ := d.Get("dual_auth_delete_enabled").(bool)
+	if err1 != nil && dualAuthDelete && isKmsDualAuthDeleteConflict(err1) {
+		// A key with a dual authorization delete policy must be set for deletion by one user and deleted by another
+		if err := kpAPI.InitiateDualAuthDelete(context.Background(), keyid); err != nil {
+			log.Printf("[DEBUG] Setting key %s for deletion failed, it may already be set for deletion: %s", keyid, err)
+		}
+		return flex.FmtErrorf("[ERROR] Key %s has a dual authorization delete policy and could not be deleted: %s. The key is set for deletion; a second user with the Manager role must delete it within 7 days, for example by running terraform destroy", keyid, err1)
+	}
 	if err1 != nil {
 		registrations := d.Get("registrations").([]interface{})
 		var registrationLog error
@@ -334,8 +695,11 @@ func setKeyDetails(d *schema.ResourceData, meta interface{}, instanceID string,
 	d.Set("standard_key", key.Extractable)
 	d.Set("payload", d.Get("payload"))
 	d.Set("description", key.Description)
-	d.Set("encrypted_nonce", key.EncryptedNonce)
-	d.Set("iv_value", key.IV)
+	// The nonce of a secure import is generated by the provider and not part of the configuration
+	if secureImport, _ := d.Get("secure_import").(bool); !secureImport {
+		d.Set("encrypted_nonce", key.EncryptedNonce)
+		d.Set("iv_value", key.IV)
+	}
 	d.Set("key_name", key.Name)
 	d.Set("crn", key.CRN)
 	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
@@ -358,6 +722,11 @@ func setKeyDetails(d *schema.ResourceData, meta interface{}, instanceID string,
 	d.Set(flex.ResourceCRN, key.CRN)
 	state := key.State
 	d.Set(flex.ResourceStatus, strconv.Itoa(state))
+	d.Set("state", kmsKeyStateNames[kp.KeyState(state)])
+	d.Set("dual_auth_delete_enabled", key.DualAuthDelete != nil && key.DualAuthDelete.Enabled != nil && *key.DualAuthDelete.Enabled)
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	}
 	rcontroller, err := flex.GetBaseController(meta)
 	if err != nil {
 		return err
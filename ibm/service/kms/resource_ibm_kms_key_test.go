@@ -6,6 +6,8 @@ package kms_test
 import (
 	"fmt"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -154,6 +156,81 @@ func TestAccIBMKMSResource_InvalidExpDate(t *testing.T) {
 	})
 }
 
+// Test for a secure import of a root key with an import token
+func TestAccIBMKMSResource_SecureImport(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	keyMaterial := "LqMWNtSi3Snr4gFNO0PsFFLFRNs57mSXCQE7O2oE+g0="
+	keyMaterialFile := filepath.Join(t.TempDir(), "key_material")
+	if err := os.WriteFile(keyMaterialFile, []byte(keyMaterial+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMKmsResourceSecureImportConfig(instanceName, keyName, ""),
+				ExpectError: regexp.MustCompile("secure_import requires key_material_file or key_material_wo"),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceSecureImportConfig(instanceName, keyName, fmt.Sprintf(`
+					key_material_wo         = "%s"
+					key_material_wo_version = 1`, keyMaterial)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_name", keyName),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "secure_import", "true"),
+					resource.TestCheckNoResourceAttr("ibm_kms_key.test", "key_material_wo"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "payload", ""),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceSecureImportConfig(instanceName, keyName, fmt.Sprintf(`
+					key_material_file = "%s"`, keyMaterialFile)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_material_file", keyMaterialFile),
+					resource.TestCheckResourceAttrSet("ibm_kms_key.test", "key_material_file_hash"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "payload", ""),
+				),
+			},
+		},
+	})
+}
+
+// Test for enabling, disabling and rotating a root key
+func TestAccIBMKMSResource_StateAndRotation(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsResourceStateConfig(instanceName, keyName, "suspended", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "suspended"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "dual_auth_delete_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceStateConfig(instanceName, keyName, "active", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "active"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key.test", "last_rotate_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceStateConfig(instanceName, keyName, "suspended", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "suspended"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMKmsResourceConfig(instanceName, resource, KeyName string, standard_key bool) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "kms_instance" {
@@ -367,3 +444,41 @@ func testAccCheckIBMKmsCreateRootKeyConfig(instanceName, resource, KeyName, expi
 // 	  }
 // `, instanceName, resource, KeyName, dual_auth_delete)
 // }
+
+func testAccCheckIBMKmsResourceSecureImportConfig(instanceName, KeyName, keyMaterial string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id   = "${ibm_resource_instance.kms_instance.guid}"
+		key_name      = "%s"
+		standard_key  = false
+		secure_import = true
+		force_delete  = true
+		%s
+	}
+`, addPrefixToResourceName(instanceName), KeyName, keyMaterial)
+}
+
+func testAccCheckIBMKmsResourceStateConfig(instanceName, KeyName, state, rotationTrigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id      = "${ibm_resource_instance.kms_instance.guid}"
+		key_name         = "%s"
+		standard_key     = false
+		state            = "%s"
+		rotation_trigger = "%s"
+		force_delete     = true
+	}
+`, addPrefixToResourceName(instanceName), KeyName, state, rotationTrigger)
+}
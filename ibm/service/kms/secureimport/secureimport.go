@@ -0,0 +1,164 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Package secureimport prepares customer key material for a secure import
+// into Key Protect or Hyper Protect Crypto Services.
+//
+// A secure import protects the key material in transit with an import token:
+// the key material is wrapped with the RSA public key of the token (RSA-OAEP
+// with SHA-256), and the nonce of the token is encrypted with the key material
+// itself to prove possession of the key. Key Protect expects the nonce to be
+// encrypted with AES-GCM, Hyper Protect Crypto Services with AES-CBC and
+// PKCS#7 padding.
+package secureimport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// NonceMode selects how the nonce of an import token is encrypted
+type NonceMode int
+
+const (
+	// NonceModeGCM encrypts the nonce with AES-GCM, as expected by Key Protect
+	NonceModeGCM NonceMode = iota
+	// NonceModeCBC encrypts the nonce with AES-CBC and PKCS#7 padding, as expected by Hyper Protect Crypto Services
+	NonceModeCBC
+)
+
+// randReader is the source of randomness for the wrapping and the IVs, replaced in tests
+var randReader io.Reader = rand.Reader
+
+// TransportKey is the public part of an import token, as returned by the import token API
+type TransportKey struct {
+	// Payload is the base64 encoded PEM public key of the import token
+	Payload string
+	// Nonce is the base64 encoded nonce of the import token
+	Nonce string
+}
+
+// ImportRequest holds the values to send with the create key request of a secure import
+type ImportRequest struct {
+	// Payload is the base64 encoded key material, wrapped with the public key of the import token
+	Payload string
+	// EncryptedNonce is the base64 encoded nonce, encrypted with the key material
+	EncryptedNonce string
+	// IV is the base64 encoded initialization vector used to encrypt the nonce
+	IV string
+}
+
+// DecodeKeyMaterial decodes base64 encoded key material and checks that it is a
+// valid AES key of 128, 192 or 256 bits. Surrounding whitespace is ignored, so
+// the content of a key file can be passed as is.
+func DecodeKeyMaterial(encoded string) ([]byte, error) {
+	keyMaterial, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("key material must be base64 encoded: %s", err)
+	}
+	switch len(keyMaterial) {
+	case 16, 24, 32:
+		return keyMaterial, nil
+	default:
+		return nil, fmt.Errorf("key material must be a 128, 192 or 256 bit AES key, got %d bits", len(keyMaterial)*8)
+	}
+}
+
+// Prepare wraps the key material and encrypts the nonce of the transport key
+func Prepare(keyMaterial []byte, transportKey TransportKey, mode NonceMode) (*ImportRequest, error) {
+	payload, err := WrapKey(keyMaterial, transportKey.Payload)
+	if err != nil {
+		return nil, err
+	}
+	encryptedNonce, iv, err := EncryptNonce(keyMaterial, transportKey.Nonce, mode)
+	if err != nil {
+		return nil, err
+	}
+	return &ImportRequest{
+		Payload:        payload,
+		EncryptedNonce: encryptedNonce,
+		IV:             iv,
+	}, nil
+}
+
+// WrapKey encrypts the key material with the base64 encoded PEM public key of an
+// import token, using RSA-OAEP with SHA-256, and returns it base64 encoded
+func WrapKey(keyMaterial []byte, publicKey string) (string, error) {
+	pemKey, err := base64.StdEncoding.DecodeString(publicKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode the public key of the import token: %s", err)
+	}
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return "", fmt.Errorf("the public key of the import token is not PEM encoded")
+	}
+	parsedKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the public key of the import token: %s", err)
+	}
+	rsaKey, ok := parsedKey.(*rsa.PublicKey)
+	if !ok {
+		return "", fmt.Errorf("the public key of the import token is not an RSA key")
+	}
+
+	wrapped, err := rsa.EncryptOAEP(sha256.New(), randReader, rsaKey, keyMaterial, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to wrap the key material: %s", err)
+	}
+	return base64.StdEncoding.EncodeToString(wrapped), nil
+}
+
+// EncryptNonce encrypts the base64 encoded nonce of an import token with the key
+// material, using a random IV. It returns the encrypted nonce and the IV, both
+// base64 encoded.
+func EncryptNonce(keyMaterial []byte, nonce string, mode NonceMode) (encryptedNonce string, iv string, err error) {
+	plainNonce, err := base64.StdEncoding.DecodeString(nonce)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to decode the nonce of the import token: %s", err)
+	}
+	block, err := aes.NewCipher(keyMaterial)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid key material: %s", err)
+	}
+
+	var ciphertext, ivBytes []byte
+	switch mode {
+	case NonceModeGCM:
+		gcm, err := cipher.NewGCM(block)
+		if err != nil {
+			return "", "", err
+		}
+		ivBytes = make([]byte, gcm.NonceSize())
+		if _, err := io.ReadFull(randReader, ivBytes); err != nil {
+			return "", "", fmt.Errorf("failed to generate an IV: %s", err)
+		}
+		ciphertext = gcm.Seal(nil, ivBytes, plainNonce, nil)
+	case NonceModeCBC:
+		ivBytes = make([]byte, aes.BlockSize)
+		if _, err := io.ReadFull(randReader, ivBytes); err != nil {
+			return "", "", fmt.Errorf("failed to generate an IV: %s", err)
+		}
+		padded := pkcs7Pad(plainNonce, aes.BlockSize)
+		ciphertext = make([]byte, len(padded))
+		cipher.NewCBCEncrypter(block, ivBytes).CryptBlocks(ciphertext, padded)
+	default:
+		return "", "", fmt.Errorf("unknown nonce mode %d", mode)
+	}
+
+	return base64.StdEncoding.EncodeToString(ciphertext), base64.StdEncoding.EncodeToString(ivBytes), nil
+}
+
+func pkcs7Pad(data []byte, blockSize int) []byte {
+	padding := blockSize - len(data)%blockSize
+	return append(append([]byte{}, data...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+}
@@ -0,0 +1,208 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secureimport
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"testing"
+)
+
+func testTransportKey(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	return privateKey, base64.StdEncoding.EncodeToString(pemKey)
+}
+
+func testKeyMaterial() []byte {
+	return bytes.Repeat([]byte{0x2a}, 32)
+}
+
+func decodeBase64(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		t.Fatalf("%q is not base64 encoded: %s", s, err)
+	}
+	return b
+}
+
+func TestDecodeKeyMaterial(t *testing.T) {
+	for _, size := range []int{16, 24, 32} {
+		encoded := base64.StdEncoding.EncodeToString(make([]byte, size))
+		keyMaterial, err := DecodeKeyMaterial(" " + encoded + "\n")
+		if err != nil {
+			t.Errorf("%d byte key: unexpected error: %s", size, err)
+		}
+		if len(keyMaterial) != size {
+			t.Errorf("%d byte key: got %d bytes", size, len(keyMaterial))
+		}
+	}
+
+	if _, err := DecodeKeyMaterial("not base64!"); err == nil {
+		t.Error("expected an error for key material that is not base64 encoded")
+	}
+	if _, err := DecodeKeyMaterial(base64.StdEncoding.EncodeToString(make([]byte, 20))); err == nil {
+		t.Error("expected an error for a 160 bit key")
+	}
+}
+
+func TestWrapKey(t *testing.T) {
+	privateKey, publicKey := testTransportKey(t)
+	keyMaterial := testKeyMaterial()
+
+	wrapped, err := WrapKey(keyMaterial, publicKey)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	unwrapped, err := rsa.DecryptOAEP(sha256.New(), nil, privateKey, decodeBase64(t, wrapped), nil)
+	if err != nil {
+		t.Fatalf("wrapped key cannot be unwrapped with RSA-OAEP SHA-256: %s", err)
+	}
+	if !bytes.Equal(unwrapped, keyMaterial) {
+		t.Error("unwrapped key does not match the key material")
+	}
+}
+
+func TestWrapKeyInvalidPublicKey(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&ecKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]string{
+		"not base64":  "not base64!",
+		"not PEM":     base64.StdEncoding.EncodeToString([]byte("not a PEM block")),
+		"not RSA key": base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}
+	for name, publicKey := range cases {
+		if _, err := WrapKey(testKeyMaterial(), publicKey); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestEncryptNonceGCM(t *testing.T) {
+	keyMaterial := testKeyMaterial()
+	nonce := []byte("import-token-nonce")
+
+	encryptedNonce, iv, err := EncryptNonce(keyMaterial, base64.StdEncoding.EncodeToString(nonce), NonceModeGCM)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	block, _ := aes.NewCipher(keyMaterial)
+	gcm, _ := cipher.NewGCM(block)
+	ivBytes := decodeBase64(t, iv)
+	if len(ivBytes) != gcm.NonceSize() {
+		t.Fatalf("got a %d byte IV, want %d", len(ivBytes), gcm.NonceSize())
+	}
+	decrypted, err := gcm.Open(nil, ivBytes, decodeBase64(t, encryptedNonce), nil)
+	if err != nil {
+		t.Fatalf("encrypted nonce cannot be decrypted with AES-GCM: %s", err)
+	}
+	if !bytes.Equal(decrypted, nonce) {
+		t.Errorf("decrypted nonce %q does not match %q", decrypted, nonce)
+	}
+}
+
+func TestEncryptNonceCBC(t *testing.T) {
+	keyMaterial := testKeyMaterial()
+	nonce := []byte("import-token-nonce")
+
+	encryptedNonce, iv, err := EncryptNonce(keyMaterial, base64.StdEncoding.EncodeToString(nonce), NonceModeCBC)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ciphertext := decodeBase64(t, encryptedNonce)
+	if len(ciphertext)%aes.BlockSize != 0 {
+		t.Fatalf("ciphertext of %d bytes is not padded to the block size", len(ciphertext))
+	}
+	block, _ := aes.NewCipher(keyMaterial)
+	decrypted := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, decodeBase64(t, iv)).CryptBlocks(decrypted, ciphertext)
+
+	padding := int(decrypted[len(decrypted)-1])
+	if padding < 1 || padding > aes.BlockSize {
+		t.Fatalf("invalid PKCS#7 padding %d", padding)
+	}
+	if !bytes.Equal(decrypted[:len(decrypted)-padding], nonce) {
+		t.Errorf("decrypted nonce %q does not match %q", decrypted[:len(decrypted)-padding], nonce)
+	}
+}
+
+func TestEncryptNonceRandomIV(t *testing.T) {
+	nonce := base64.StdEncoding.EncodeToString([]byte("import-token-nonce"))
+
+	_, iv1, err := EncryptNonce(testKeyMaterial(), nonce, NonceModeGCM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, iv2, err := EncryptNonce(testKeyMaterial(), nonce, NonceModeGCM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if iv1 == iv2 {
+		t.Error("expected a new IV for every encryption")
+	}
+}
+
+func TestEncryptNonceFailingRandomness(t *testing.T) {
+	defer func(r io.Reader) { randReader = r }(randReader)
+	randReader = bytes.NewReader(nil)
+
+	nonce := base64.StdEncoding.EncodeToString([]byte("import-token-nonce"))
+	if _, _, err := EncryptNonce(testKeyMaterial(), nonce, NonceModeGCM); err == nil {
+		t.Error("expected an error when no IV can be generated")
+	}
+}
+
+func TestPrepare(t *testing.T) {
+	privateKey, publicKey := testTransportKey(t)
+	keyMaterial := testKeyMaterial()
+	nonce := []byte("import-token-nonce")
+
+	request, err := Prepare(keyMaterial, TransportKey{
+		Payload: publicKey,
+		Nonce:   base64.StdEncoding.EncodeToString(nonce),
+	}, NonceModeGCM)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	unwrapped, err := rsa.DecryptOAEP(sha256.New(), nil, privateKey, decodeBase64(t, request.Payload), nil)
+	if err != nil || !bytes.Equal(unwrapped, keyMaterial) {
+		t.Errorf("payload does not unwrap to the key material: %v", err)
+	}
+	if request.EncryptedNonce == "" || request.IV == "" {
+		t.Error("expected an encrypted nonce and an IV")
+	}
+	if request.Payload == base64.StdEncoding.EncodeToString(keyMaterial) {
+		t.Error("payload must not contain the plaintext key material")
+	}
+}
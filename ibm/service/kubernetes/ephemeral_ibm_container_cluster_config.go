@@ -0,0 +1,413 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &containerClusterConfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &containerClusterConfigEphemeralResource{}
+)
+
+// clusterConfigClient is the subset of the bluemix-go REST client used to fetch
+// the kubeconfig archive into memory instead of onto disk.
+type clusterConfigClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*http.Response, error)
+}
+
+// openShiftTokenFetcher is implemented by the containerv2 clusters client.
+type openShiftTokenFetcher interface {
+	FetchOCTokenForKubeConfig(kubecfg []byte, cMeta *containerv2.ClusterInfo, skipSSLVerification bool, endpointType string) ([]byte, string, error)
+}
+
+func NewContainerClusterConfigEphemeralResource() ephemeral.EphemeralResource {
+	return &containerClusterConfigEphemeralResource{}
+}
+
+type containerClusterConfigEphemeralResource struct {
+	csClient containerv2.ContainerServiceAPI
+}
+
+type clusterConfigEphemeralModel struct {
+	ClusterNameID    types.String `tfsdk:"cluster_name_id"`
+	ResourceGroupID  types.String `tfsdk:"resource_group_id"`
+	Admin            types.Bool   `tfsdk:"admin"`
+	EndpointType     types.String `tfsdk:"endpoint_type"`
+	Host             types.String `tfsdk:"host"`
+	CACertificate    types.String `tfsdk:"ca_certificate"`
+	Token            types.String `tfsdk:"token"`
+	TokenExpiration  types.String `tfsdk:"token_expiration"`
+	AdminCertificate types.String `tfsdk:"admin_certificate"`
+	AdminKey         types.String `tfsdk:"admin_key"`
+	KubeConfig       types.String `tfsdk:"kube_config"`
+}
+
+// clusterConfigDetails holds the contents of a kubeconfig archive.
+type clusterConfigDetails struct {
+	host             string
+	caCertificate    string
+	token            string
+	adminCertificate string
+	adminKey         string
+	kubeConfig       []byte
+}
+
+func (e *containerClusterConfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = "ibm_container_cluster_config"
+}
+
+func (e *containerClusterConfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Retrieves the connection details of a cluster without writing the kubeconfig to disk. The values are never stored in the plan or state.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The name or ID of the cluster.",
+			},
+			"resource_group_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "ID of the resource group.",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If set to true, the admin client certificate and key are returned. Default: false",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "The cluster service endpoint to use in the kubeconfig, for example `private` or `vpe`. If not specified, the default endpoint of the cluster is used.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "The URL of the cluster API server.",
+			},
+			"ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "The PEM encoded certificate authority of the cluster API server.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The short-lived bearer token used to authenticate with the cluster API server.",
+			},
+			"token_expiration": schema.StringAttribute{
+				Computed:    true,
+				Description: "The expiration time of the token in RFC 3339 format, when it can be determined.",
+			},
+			"admin_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client certificate. Set only when admin is true.",
+			},
+			"admin_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded admin client key. Set only when admin is true.",
+			},
+			"kube_config": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The raw kubeconfig YAML of the cluster.",
+			},
+		},
+	}
+}
+
+func (e *containerClusterConfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	csClient, err := session.VpcContainerAPI()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create VPC Container Client",
+			"An unexpected error occurred when creating the VPC Container client.\n\n"+
+				"VPC Container Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	e.csClient = csClient
+}
+
+func (e *containerClusterConfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config clusterConfigEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := config.ClusterNameID.ValueString()
+	admin := config.Admin.ValueBool()
+	endpointType := config.EndpointType.ValueString()
+	target := containerv2.ClusterTargetHeader{
+		ResourceGroup: config.ResourceGroupID.ValueString(),
+	}
+
+	var details clusterConfigDetails
+	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+		var err error
+		details, err = fetchClusterConfigInMemory(e.csClient, name, admin, target, endpointType)
+		if err != nil {
+			log.Printf("[DEBUG] Failed to fetch cluster config err %s", err)
+			if isRetryableClusterConfigError(err) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Failed to Retrieve Cluster Config",
+			fmt.Sprintf("Failed to retrieve the cluster config of '%s': %s", name, err.Error()),
+		)
+		return
+	}
+
+	config.Host = types.StringValue(details.host)
+	config.CACertificate = types.StringValue(details.caCertificate)
+	config.Token = types.StringValue(details.token)
+	config.TokenExpiration = types.StringNull()
+	if exp, ok := tokenExpiration(details.token); ok {
+		config.TokenExpiration = types.StringValue(exp.UTC().Format(time.RFC3339))
+	}
+	config.AdminCertificate = types.StringNull()
+	config.AdminKey = types.StringNull()
+	if admin {
+		config.AdminCertificate = types.StringValue(details.adminCertificate)
+		config.AdminKey = types.StringValue(details.adminKey)
+	}
+	config.KubeConfig = types.StringValue(string(details.kubeConfig))
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// fetchClusterConfigInMemory mirrors containerv2 GetClusterConfigDetail, but
+// keeps the kubeconfig archive in memory.
+func fetchClusterConfigInMemory(csClient containerv2.ContainerServiceAPI, name string, admin bool, target containerv2.ClusterTargetHeader, endpointType string) (clusterConfigDetails, error) {
+	var details clusterConfigDetails
+
+	client, ok := csClient.(clusterConfigClient)
+	if !ok {
+		return details, fmt.Errorf("the container service client does not support in-memory cluster config retrieval")
+	}
+
+	clusterAPI := csClient.Clusters()
+	cluster, err := clusterAPI.GetCluster(name, target)
+	if err != nil {
+		return details, err
+	}
+
+	postBody := map[string]interface{}{
+		"cluster": name,
+		"format":  "zip",
+	}
+	if admin {
+		postBody["admin"] = true
+	}
+	if cluster.Provider == "satellite" {
+		postBody["endpointType"] = "link"
+		postBody["admin"] = true
+	} else if endpointType != "" {
+		postBody["endpointType"] = endpointType
+	}
+
+	archive := &bytes.Buffer{}
+	if _, err := client.Post("/v2/applyRBACAndGetKubeconfig", postBody, archive, target.ToMap()); err != nil {
+		return details, err
+	}
+	waitForClusterRBACSync(client, name, target)
+
+	details, err = parseClusterConfigArchive(archive.Bytes())
+	if err != nil {
+		return details, err
+	}
+
+	if cluster.Type == "openshift" && cluster.Provider != "satellite" {
+		fetcher, ok := clusterAPI.(openShiftTokenFetcher)
+		if !ok {
+			return details, fmt.Errorf("the container service client does not support OpenShift token retrieval")
+		}
+		kubeConfig, host, err := fetcher.FetchOCTokenForKubeConfig(details.kubeConfig, cluster, cluster.IsStagingSatelliteCluster(), endpointType)
+		if err != nil {
+			return details, err
+		}
+		if err := details.setKubeConfig(kubeConfig); err != nil {
+			return details, err
+		}
+		if host != "" {
+			details.host = host
+		}
+	}
+
+	return details, nil
+}
+
+// waitForClusterRBACSync waits for the RBAC of the requesting user to be
+// synchronized. Like the bluemix-go helper it only logs when the wait gives up.
+func waitForClusterRBACSync(client clusterConfigClient, name string, target containerv2.ClusterTargetHeader) {
+	u := url.URL{Path: "/v2/getRBACStatus"}
+	query := u.Query()
+	query.Set("cluster", name)
+	u.RawQuery = query.Encode()
+
+	status := struct {
+		Synchronized bool `json:"synchronized"`
+		Error        bool `json:"error"`
+	}{}
+	for backoff := time.Second; backoff <= 32*time.Second; backoff *= 2 {
+		if _, err := client.Get(u.String(), &status, target.ToMap()); err != nil {
+			log.Printf("[WARN] Failed to get the RBAC status of cluster %s: %s", name, err)
+			return
+		}
+		if status.Synchronized {
+			return
+		}
+		if status.Error {
+			log.Printf("[WARN] An error occurred while waiting for RBAC of cluster %s to synchronize", name)
+			return
+		}
+		time.Sleep(backoff)
+	}
+	log.Printf("[WARN] Timed out while waiting for RBAC of cluster %s to synchronize", name)
+}
+
+// parseClusterConfigArchive extracts the kubeconfig and certificates from the
+// zip archive returned by the applyRBACAndGetKubeconfig API.
+func parseClusterConfigArchive(archive []byte) (clusterConfigDetails, error) {
+	var details clusterConfigDetails
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		return details, fmt.Errorf("failed to read the cluster config archive: %s", err)
+	}
+
+	var kubeConfig []byte
+	for _, f := range reader.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		name := path.Base(f.Name)
+		content, err := readZipFile(f)
+		if err != nil {
+			return details, err
+		}
+		switch {
+		case name == "admin-key.pem":
+			details.adminKey = string(content)
+		case name == "admin.pem":
+			details.adminCertificate = string(content)
+		case strings.HasPrefix(name, "ca") && strings.HasSuffix(name, ".pem"):
+			details.caCertificate = string(content)
+		case strings.HasSuffix(name, ".yaml") || strings.HasSuffix(name, ".yml"):
+			kubeConfig = content
+		}
+	}
+	if kubeConfig == nil {
+		return details, fmt.Errorf("unable to locate the kubeconfig in the cluster config archive")
+	}
+
+	if err := details.setKubeConfig(kubeConfig); err != nil {
+		return details, err
+	}
+	return details, nil
+}
+
+// setKubeConfig records the raw kubeconfig and the host and token it contains.
+func (details *clusterConfigDetails) setKubeConfig(kubeConfig []byte) error {
+	config, err := clientcmd.Load(kubeConfig)
+	if err != nil {
+		return fmt.Errorf("failed to parse the kubeconfig: %s", err)
+	}
+	details.kubeConfig = kubeConfig
+
+	kubeContext, ok := config.Contexts[config.CurrentContext]
+	if !ok {
+		for _, c := range config.Contexts {
+			kubeContext = c
+			break
+		}
+	}
+	if kubeContext == nil {
+		return nil
+	}
+	if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+		details.host = cluster.Server
+	}
+	if authInfo, ok := config.AuthInfos[kubeContext.AuthInfo]; ok {
+		if authInfo.Token != "" {
+			details.token = authInfo.Token
+		} else if authInfo.AuthProvider != nil {
+			details.token = authInfo.AuthProvider.Config["id-token"]
+		}
+	}
+	return nil
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s in the cluster config archive: %s", f.Name, err)
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// tokenExpiration returns the exp claim of a JWT. OpenShift tokens are opaque
+// and have no expiration that can be read.
+func tokenExpiration(token string) (time.Time, bool) {
+	if token == "" {
+		return time.Time{}, false
+	}
+	claims := jwt.MapClaims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return time.Time{}, false
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil || exp == nil {
+		return time.Time{}, false
+	}
+	return exp.Time, true
+}
+
+func isRetryableClusterConfigError(err error) bool {
+	msg := err.Error()
+	if strings.Contains(strings.ToLower(msg), "could not login to openshift account") {
+		return true
+	}
+	// Intermittent error resulting from synchronisation delay
+	return strings.Contains(msg, "Error: lookup of user for") && strings.Contains(msg, "failed")
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerClusterConfigEphemeralBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"kubernetes": {
+				Source:            "hashicorp/kubernetes",
+				VersionConstraint: "~> 2.30",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerClusterConfigEphemeral(acc.IksClusterID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.kubernetes_namespace.default", "metadata.0.name", "default"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerClusterConfigEphemeral(clusterID string) string {
+	return fmt.Sprintf(`
+ephemeral "ibm_container_cluster_config" "cluster" {
+  cluster_name_id = "%s"
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.ibm_container_cluster_config.cluster.host
+  cluster_ca_certificate = ephemeral.ibm_container_cluster_config.cluster.ca_certificate
+  token                  = ephemeral.ibm_container_cluster_config.cluster.token
+}
+
+data "kubernetes_namespace" "default" {
+  metadata {
+    name = "default"
+  }
+}`, clusterID)
+}
@@ -0,0 +1,202 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testClusterConfigKubeConfig = `apiVersion: v1
+kind: Config
+current-context: mycluster
+clusters:
+- name: mycluster
+  cluster:
+    server: https://c1.us-south.containers.cloud.ibm.com:30000
+- name: other
+  cluster:
+    server: https://other.example.com:443
+contexts:
+- name: mycluster
+  context:
+    cluster: mycluster
+    user: admin
+- name: other
+  context:
+    cluster: other
+    user: other
+users:
+- name: admin
+  user:
+    token: admin-token
+- name: other
+  user:
+    token: other-token
+`
+
+func testClusterConfigArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := f.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestParseClusterConfigArchive(t *testing.T) {
+	archive := testClusterConfigArchive(t, map[string]string{
+		"mycluster/kube-config.yaml":          testClusterConfigKubeConfig,
+		"mycluster/ca-us-south-mycluster.pem": "ca-cert",
+		"mycluster/admin.pem":                 "admin-cert",
+		"mycluster/admin-key.pem":             "admin-key",
+	})
+
+	details, err := parseClusterConfigArchive(archive)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if details.host != "https://c1.us-south.containers.cloud.ibm.com:30000" {
+		t.Errorf("got host %q", details.host)
+	}
+	if details.token != "admin-token" {
+		t.Errorf("got token %q", details.token)
+	}
+	if details.caCertificate != "ca-cert" || details.adminCertificate != "admin-cert" || details.adminKey != "admin-key" {
+		t.Errorf("got certificates %q, %q, %q", details.caCertificate, details.adminCertificate, details.adminKey)
+	}
+	if string(details.kubeConfig) != testClusterConfigKubeConfig {
+		t.Error("expected the raw kubeconfig")
+	}
+}
+
+func TestParseClusterConfigArchiveErrors(t *testing.T) {
+	if _, err := parseClusterConfigArchive([]byte("not a zip archive")); err == nil {
+		t.Error("expected an error for an invalid archive")
+	}
+
+	archive := testClusterConfigArchive(t, map[string]string{"mycluster/admin.pem": "admin-cert"})
+	if _, err := parseClusterConfigArchive(archive); err == nil {
+		t.Error("expected an error for an archive without a kubeconfig")
+	}
+
+	archive = testClusterConfigArchive(t, map[string]string{"mycluster/kube-config.yaml": "clusters: ["})
+	if _, err := parseClusterConfigArchive(archive); err == nil {
+		t.Error("expected an error for an invalid kubeconfig")
+	}
+}
+
+func TestSetKubeConfig(t *testing.T) {
+	cases := map[string]struct {
+		kubeConfig string
+		host       string
+		token      string
+	}{
+		"current context": {
+			kubeConfig: testClusterConfigKubeConfig,
+			host:       "https://c1.us-south.containers.cloud.ibm.com:30000",
+			token:      "admin-token",
+		},
+		"auth provider token": {
+			kubeConfig: `apiVersion: v1
+kind: Config
+current-context: mycluster
+clusters:
+- name: mycluster
+  cluster:
+    server: https://c1.us-south.containers.cloud.ibm.com:30000
+contexts:
+- name: mycluster
+  context:
+    cluster: mycluster
+    user: iam
+users:
+- name: iam
+  user:
+    auth-provider:
+      name: oidc
+      config:
+        id-token: iam-id-token
+`,
+			host:  "https://c1.us-south.containers.cloud.ibm.com:30000",
+			token: "iam-id-token",
+		},
+		"no current context": {
+			kubeConfig: `apiVersion: v1
+kind: Config
+clusters:
+- name: mycluster
+  cluster:
+    server: https://c1.us-south.containers.cloud.ibm.com:30000
+contexts:
+- name: mycluster
+  context:
+    cluster: mycluster
+    user: admin
+users:
+- name: admin
+  user:
+    token: admin-token
+`,
+			host:  "https://c1.us-south.containers.cloud.ibm.com:30000",
+			token: "admin-token",
+		},
+		"no context": {
+			kubeConfig: "apiVersion: v1\nkind: Config\n",
+		},
+	}
+
+	for name, c := range cases {
+		var details clusterConfigDetails
+		if err := details.setKubeConfig([]byte(c.kubeConfig)); err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+			continue
+		}
+		if details.host != c.host || details.token != c.token {
+			t.Errorf("%s: got host %q and token %q, want %q and %q", name, details.host, details.token, c.host, c.token)
+		}
+		if string(details.kubeConfig) != c.kubeConfig {
+			t.Errorf("%s: expected the raw kubeconfig", name)
+		}
+	}
+}
+
+func TestTokenExpiration(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"exp": exp.Unix()}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := tokenExpiration(token); !ok || !got.Equal(exp) {
+		t.Errorf("got %v, %t, want %v", got, ok, exp)
+	}
+
+	noExp, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{"sub": "user"}).SignedString([]byte("secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, token := range map[string]string{
+		"empty":         "",
+		"opaque":        "sha256~openshift-token",
+		"no expiration": noExp,
+	} {
+		if _, ok := tokenExpiration(token); ok {
+			t.Errorf("%s: expected no expiration", name)
+		}
+	}
+}
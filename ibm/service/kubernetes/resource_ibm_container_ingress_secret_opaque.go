@@ -4,7 +4,9 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
@@ -18,12 +20,13 @@ import (
 
 func ResourceIBMContainerIngressSecretOpaque() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerIngressSecretOpaqueCreate,
-		Read:     resourceIBMContainerIngressSecretOpaqueRead,
-		Update:   resourceIBMContainerIngressSecretOpaqueUpdate,
-		Delete:   resourceIBMContainerIngressSecretOpaqueDelete,
-		Exists:   resourceIBMContainerIngressSecretOpaqueExists,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMContainerIngressSecretOpaqueCreate,
+		Read:          resourceIBMContainerIngressSecretOpaqueRead,
+		Update:        resourceIBMContainerIngressSecretOpaqueUpdate,
+		Delete:        resourceIBMContainerIngressSecretOpaqueDelete,
+		Exists:        resourceIBMContainerIngressSecretOpaqueExists,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMContainerIngressSecretOpaqueCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Update: schema.DefaultTimeout(2 * time.Minute),
@@ -81,6 +84,18 @@ func ResourceIBMContainerIngressSecretOpaque() *schema.Resource {
 				Optional:    true,
 				Description: "Updates secret from secrets manager if value is changed (increment each usage)",
 			},
+			"auto_refresh": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Updates secret from secrets manager during apply if the current version of a field differs from secrets_manager_versions",
+			},
+			"secrets_manager_versions": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ID of the secrets manager version of each field CRN that the cluster holds",
+			},
 			"last_updated_timestamp": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -233,6 +248,29 @@ func resourceIBMContainerIngressSecretOpaqueRead(d *schema.ResourceData, meta in
 	if len(ingressSecretConfig.Fields) > 0 {
 		d.Set("fields", flex.FlattenOpaqueSecret(ingressSecretConfig.Fields))
 	}
+
+	recorded := d.Get("secrets_manager_versions").(map[string]interface{})
+	versions := map[string]string{}
+	for _, field := range ingressSecretConfig.Fields {
+		if _, ok := versions[field.CRN]; ok || !isSecretsManagerCRN(field.CRN) {
+			continue
+		}
+		recordedID, _ := recorded[field.CRN].(string)
+		version, err := getSecretsManagerCurrentVersion(meta, field.CRN)
+		if err != nil {
+			log.Printf("[WARN] Error getting the current secrets manager version of %s: %s", field.CRN, err)
+			if recordedID != "" {
+				versions[field.CRN] = recordedID
+			}
+			continue
+		}
+		if ingressSecretHoldsVersion(version, recordedID, ingressSecretConfig.LastUpdatedTimestamp) {
+			versions[field.CRN] = version.ID
+		} else {
+			versions[field.CRN] = recordedID
+		}
+	}
+	d.Set("secrets_manager_versions", versions)
 	return nil
 }
 
@@ -372,10 +410,82 @@ func resourceIBMContainerIngressSecretOpaqueUpdate(d *schema.ResourceData, meta
 		if err != nil {
 			return err
 		}
+	} else if d.Get("auto_refresh").(bool) && d.HasChange("secrets_manager_versions") {
+		log.Printf("[INFO] Refreshing ingress secret %s/%s of cluster (%s) with the current secrets manager versions", secretNamespace, secretName, cluster)
+		_, err = ingressAPI.UpdateIngressSecret(params)
+		if err != nil {
+			return err
+		}
+	} else {
+		return resourceIBMContainerIngressSecretOpaqueRead(d, meta)
 	}
+
+	// The cluster now holds the current versions, record them on read
+	d.Set("secrets_manager_versions", map[string]string{})
 	return resourceIBMContainerIngressSecretOpaqueRead(d, meta)
 }
 
+// resourceIBMContainerIngressSecretOpaqueCustomizeDiff shows a diff on secrets_manager_versions when auto_refresh is set and the current
+// secrets manager version of a field is not the one the cluster holds.
+func resourceIBMContainerIngressSecretOpaqueCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("fields") || d.HasChange("update_secret") {
+		// The secret is copied on apply, which records the versions it copies
+		for _, key := range []string{"secrets_manager_versions", "last_updated_timestamp"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// Without auto_refresh the current versions are not copied on apply, so the diff would never converge
+	if !d.Get("auto_refresh").(bool) {
+		return nil
+	}
+	recorded := d.Get("secrets_manager_versions").(map[string]interface{})
+	current := map[string]interface{}{}
+	changed := false
+	for _, crn := range opaqueSecretFieldCRNs(d.Get("fields").(*schema.Set)) {
+		if !isSecretsManagerCRN(crn) {
+			continue
+		}
+		version, err := getSecretsManagerCurrentVersion(meta, crn)
+		if err != nil {
+			log.Printf("[WARN] Error getting the current secrets manager version of %s: %s", crn, err)
+			if id, ok := recorded[crn]; ok {
+				current[crn] = id
+			}
+			continue
+		}
+		current[crn] = version.ID
+		if recorded[crn] != version.ID {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	if err := d.SetNew("secrets_manager_versions", current); err != nil {
+		return err
+	}
+	return d.SetNewComputed("last_updated_timestamp")
+}
+
+func opaqueSecretFieldCRNs(fields *schema.Set) []string {
+	crns := []string{}
+	seen := map[string]bool{}
+	for _, field := range fields.List() {
+		crn, _ := field.(map[string]interface{})["crn"].(string)
+		if crn != "" && !seen[crn] {
+			seen[crn] = true
+			crns = append(crns, crn)
+		}
+	}
+	return crns
+}
+
 func resourceIBMContainerIngressSecretOpaqueExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
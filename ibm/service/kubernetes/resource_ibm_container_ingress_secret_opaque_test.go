@@ -194,6 +194,46 @@ func TestAccIBMContainerIngressSecretOpaque_ForceUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerIngressSecretOpaque_AutoRefresh(t *testing.T) {
+	secretName := fmt.Sprintf("tf-container-ingress-secret-name-opaque-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressSecretOpaqueAutoRefresh(secretName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_opaque.secret", "auto_refresh", "true"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_opaque.secret", "secrets_manager_versions.%", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_ingress_secret_opaque.secret", "last_updated_timestamp"),
+				),
+			},
+			{
+				Config:             testAccCheckIBMContainerIngressSecretOpaqueAutoRefresh(secretName, true),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				Config: testAccCheckIBMContainerIngressSecretOpaqueAutoRefresh(secretName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_opaque.secret", "auto_refresh", "false"),
+				),
+			},
+			{
+				Config:             testAccCheckIBMContainerIngressSecretOpaqueAutoRefresh(secretName, false),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+		},
+	})
+}
+
 func testAccCheckIBMContainerIngressSecretOpaqueDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ibm_container_ingress_secret_opaque" {
@@ -279,3 +319,17 @@ resource "ibm_container_ingress_secret_opaque" "secret" {
   }
 }`, secretName, "ibm-cert-store", acc.ClusterName, true, 1, acc.SecretCRN)
 }
+
+func testAccCheckIBMContainerIngressSecretOpaqueAutoRefresh(secretName string, autoRefresh bool) string {
+	return fmt.Sprintf(`
+resource "ibm_container_ingress_secret_opaque" "secret" {
+  secret_name = "%s"
+  secret_namespace = "%s"
+  cluster  = "%s"
+  persistence = "%t"
+  auto_refresh = %t
+  fields {
+	crn = "%s"
+  }
+}`, secretName, "ibm-cert-store", acc.ClusterName, true, autoRefresh, acc.SecretCRN)
+}
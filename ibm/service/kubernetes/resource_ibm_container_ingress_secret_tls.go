@@ -4,25 +4,34 @@
 package kubernetes
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/go-openapi/strfmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func ResourceIBMContainerIngressSecretTLS() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerIngressSecretTLSCreate,
-		Read:     resourceIBMContainerIngressSecretTLSRead,
-		Update:   resourceIBMContainerIngressSecretTLSUpdate,
-		Delete:   resourceIBMContainerIngressSecretTLSDelete,
-		Exists:   resourceIBMContainerIngressSecretTLSExists,
-		Importer: &schema.ResourceImporter{},
+		Create:        resourceIBMContainerIngressSecretTLSCreate,
+		Read:          resourceIBMContainerIngressSecretTLSRead,
+		Update:        resourceIBMContainerIngressSecretTLSUpdate,
+		Delete:        resourceIBMContainerIngressSecretTLSDelete,
+		Exists:        resourceIBMContainerIngressSecretTLSExists,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMContainerIngressSecretTLSCustomizeDiff,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
 			Update: schema.DefaultTimeout(2 * time.Minute),
@@ -100,6 +109,22 @@ func ResourceIBMContainerIngressSecretTLS() *schema.Resource {
 				Optional:    true,
 				Description: "Updates secret from secrets manager if value is changed (increment each usage)",
 			},
+			"auto_refresh": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Updates secret from secrets manager during apply if the current certificate version differs from secrets_manager_version_id",
+			},
+			"secrets_manager_version_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the secrets manager version of the certificate that the cluster holds",
+			},
+			"secrets_manager_version_created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation date of the secrets manager version of the certificate that the cluster holds",
+			},
 		},
 	}
 }
@@ -194,6 +219,16 @@ func resourceIBMContainerIngressSecretTLSRead(d *schema.ResourceData, meta inter
 	d.Set("user_managed", ingressSecretConfig.UserManaged)
 	d.Set("last_updated_timestamp", ingressSecretConfig.LastUpdatedTimestamp)
 
+	if isSecretsManagerCRN(ingressSecretConfig.CRN) {
+		version, err := getSecretsManagerCurrentVersion(meta, ingressSecretConfig.CRN)
+		if err != nil {
+			log.Printf("[WARN] Error getting the current secrets manager version of %s: %s", ingressSecretConfig.CRN, err)
+		} else if ingressSecretHoldsVersion(version, d.Get("secrets_manager_version_id").(string), ingressSecretConfig.LastUpdatedTimestamp) {
+			d.Set("secrets_manager_version_id", version.ID)
+			d.Set("secrets_manager_version_created_at", version.CreatedAt.Format(time.RFC3339))
+		}
+	}
+
 	return nil
 }
 
@@ -260,11 +295,61 @@ func resourceIBMContainerIngressSecretTLSUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return err
 		}
+	} else if d.Get("auto_refresh").(bool) && d.HasChange("secrets_manager_version_id") {
+		log.Printf("[INFO] Refreshing ingress secret %s/%s of cluster (%s) with the current secrets manager version", secretNamespace, secretName, cluster)
+		_, err = ingressAPI.UpdateIngressSecret(params)
+		if err != nil {
+			return err
+		}
+	} else {
+		return resourceIBMContainerIngressSecretTLSRead(d, meta)
 	}
 
+	// The cluster now holds the current version, record it on read
+	d.Set("secrets_manager_version_id", "")
 	return resourceIBMContainerIngressSecretTLSRead(d, meta)
 }
 
+// resourceIBMContainerIngressSecretTLSCustomizeDiff shows a diff on secrets_manager_version_id when auto_refresh is set and the current
+// secrets manager version of the certificate is not the one the cluster holds.
+func resourceIBMContainerIngressSecretTLSCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange("cert_crn") || d.HasChange("update_secret") {
+		// The secret is copied on apply, which records the version it copies
+		for _, key := range []string{"secrets_manager_version_id", "secrets_manager_version_created_at", "last_updated_timestamp"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// Without auto_refresh the current version is not copied on apply, so the diff would never converge
+	if !d.Get("auto_refresh").(bool) {
+		return nil
+	}
+	crn := d.Get("cert_crn").(string)
+	if !isSecretsManagerCRN(crn) {
+		return nil
+	}
+	version, err := getSecretsManagerCurrentVersion(meta, crn)
+	if err != nil {
+		log.Printf("[WARN] Error getting the current secrets manager version of %s: %s", crn, err)
+		return nil
+	}
+	if version.ID == d.Get("secrets_manager_version_id").(string) {
+		return nil
+	}
+	if err := d.SetNew("secrets_manager_version_id", version.ID); err != nil {
+		return err
+	}
+	if err := d.SetNew("secrets_manager_version_created_at", version.CreatedAt.Format(time.RFC3339)); err != nil {
+		return err
+	}
+	return d.SetNewComputed("last_updated_timestamp")
+}
+
 func resourceIBMContainerIngressSecretTLSExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	ingressClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -293,3 +378,64 @@ func resourceIBMContainerIngressSecretTLSExists(d *schema.ResourceData, meta int
 
 	return ingressSecretConfig.Name == secretName && ingressSecretConfig.Namespace == secretNamespace && ingressSecretConfig.Status != "deleted", nil
 }
+
+type secretsManagerVersion struct {
+	ID        string
+	CreatedAt time.Time
+}
+
+func isSecretsManagerCRN(crn string) bool {
+	return strings.Contains(crn, ":secrets-manager:")
+}
+
+func getSecretsManagerCurrentVersion(meta interface{}, secretCRN string) (*secretsManagerVersion, error) {
+	smClient, secretID, err := secretsmanager.GetClientForSecretCRN(meta.(conns.ClientSession), secretCRN)
+	if err != nil {
+		return nil, err
+	}
+	versionIntf, _, err := smClient.GetSecretVersionMetadata(&secretsmanagerv2.GetSecretVersionMetadataOptions{
+		SecretID: &secretID,
+		ID:       core.StringPtr("current"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The version metadata differs per secret type, but every type has id and created_at
+	data, err := json.Marshal(versionIntf)
+	if err != nil {
+		return nil, err
+	}
+	var version struct {
+		ID        string          `json:"id"`
+		CreatedAt strfmt.DateTime `json:"created_at"`
+	}
+	if err := json.Unmarshal(data, &version); err != nil {
+		return nil, err
+	}
+	return &secretsManagerVersion{ID: version.ID, CreatedAt: time.Time(version.CreatedAt)}, nil
+}
+
+// ingressSecretHoldsVersion reports whether an ingress secret last copied to the cluster at lastUpdated holds the current secrets
+// manager version rather than recorded, the version recorded in state. A version created after the copy is not in the cluster yet.
+func ingressSecretHoldsVersion(current *secretsManagerVersion, recorded, lastUpdated string) bool {
+	if recorded == "" || recorded == current.ID {
+		return true
+	}
+	updatedAt, ok := parseIngressSecretTimestamp(lastUpdated)
+	if !ok {
+		return false
+	}
+	return !current.CreatedAt.After(updatedAt)
+}
+
+func parseIngressSecretTimestamp(timestamp string) (time.Time, bool) {
+	layouts := []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999999 -0700 MST", "2006-01-02T15:04:05.999999999-0700"}
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, timestamp); err == nil {
+			return t, true
+		}
+	}
+	log.Printf("[WARN] Unrecognized ingress secret timestamp %q, keeping the recorded secrets manager version", timestamp)
+	return time.Time{}, false
+}
@@ -176,6 +176,40 @@ func TestAccIBMContainerIngressSecretTLS_BasicForceUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerIngressSecretTLS_AutoRefresh(t *testing.T) {
+	secretName := fmt.Sprintf("tf-container-ingress-secret-name-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressSecretTLSAutoRefresh(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_tls.secret", "auto_refresh", "true"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_ingress_secret_tls.secret", "secrets_manager_version_id"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_ingress_secret_tls.secret", "secrets_manager_version_created_at"),
+				),
+			},
+			{
+				Config:             testAccCheckIBMContainerIngressSecretTLSAutoRefresh(secretName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: false,
+			},
+			{
+				ResourceName:            "ibm_container_ingress_secret_tls.secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"region", "issuer_name", "update_secret", "auto_refresh"},
+			},
+		},
+	})
+}
+
 func testAccCheckIBMContainerIngressSecretDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ibm_container_ingress_secret_tls" {
@@ -239,3 +273,15 @@ resource "ibm_container_ingress_secret_tls" "secret" {
   update_secret = "%d"
 }`, acc.ClusterName, secretName, "ibm-cert-store", acc.CertCRN, true, 1)
 }
+
+func testAccCheckIBMContainerIngressSecretTLSAutoRefresh(secretName string) string {
+	return fmt.Sprintf(`
+resource "ibm_container_ingress_secret_tls" "secret" {
+  cluster  = "%s"
+  secret_name = "%s"
+  secret_namespace = "%s"
+  cert_crn    = "%s"
+  persistence = "%t"
+  auto_refresh = true
+}`, acc.ClusterName, secretName, "ibm-cert-store", acc.CertCRN, true)
+}
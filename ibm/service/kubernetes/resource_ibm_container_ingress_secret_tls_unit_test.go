@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIngressSecretHoldsVersion(t *testing.T) {
+	current := &secretsManagerVersion{ID: "v2", CreatedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}
+
+	cases := map[string]struct {
+		recorded    string
+		lastUpdated string
+		holds       bool
+	}{
+		"nothing recorded":      {recorded: "", lastUpdated: "", holds: true},
+		"current recorded":      {recorded: "v2", lastUpdated: "2026-02-01T00:00:00Z", holds: true},
+		"copied after creation": {recorded: "v1", lastUpdated: "2026-03-01T13:00:00Z", holds: true},
+		"copied before":         {recorded: "v1", lastUpdated: "2026-02-01T00:00:00Z", holds: false},
+		"go time format":        {recorded: "v1", lastUpdated: "2026-03-01 13:00:00.123 +0000 UTC", holds: true},
+		"unknown format":        {recorded: "v1", lastUpdated: "yesterday", holds: false},
+	}
+
+	for name, c := range cases {
+		if got := ingressSecretHoldsVersion(current, c.recorded, c.lastUpdated); got != c.holds {
+			t.Errorf("%s: got %t, want %t", name, got, c.holds)
+		}
+	}
+}
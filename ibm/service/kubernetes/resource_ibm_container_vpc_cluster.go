@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,6 +18,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -56,6 +63,8 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				return flex.OnlyInUpdateDiff([]string{EnableSecureByDefaultFlag}, diff)
 			},
+			resourceIBMContainerVpcClusterUpgradeStrategyCustomizeDiff,
+			resourceIBMContainerVpcClusterWorkerUpgradeCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -189,6 +198,152 @@ func ResourceIBMContainerVpcCluster() *schema.Resource {
 				Description: "Wait for worker node to update during kube version update.",
 			},
 
+			"upgrade_strategy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "How worker nodes are replaced during kube version and patch updates. Without it, workers are replaced one at a time and the first failure stops the update.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_unavailable_per_pool": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of workers of a worker pool that are replaced at the same time.",
+						},
+						"max_unavailable_per_zone": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of workers of a zone that are replaced at the same time.",
+						},
+						"batch_parallelism": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The maximum number of workers replaced in a batch.",
+						},
+						"pause_between_batches": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of seconds to wait after a batch before the next one starts.",
+						},
+						"continue_on_error_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "The number of workers that can fail to be replaced before the update stops.",
+						},
+					},
+				},
+			},
+
+			"worker_upgrade_progress": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The progress of the last worker update. While workers are pending, being replaced or failed, the plan shows a change and a new apply resumes the update.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker being replaced.",
+						},
+						"pool_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker pool of the worker.",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The zone of the worker.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the replacement: pending, replacing, updated, failed or unknown.",
+						},
+						"replacement_worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the worker that replaced the worker.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The reason the replacement failed.",
+						},
+					},
+				},
+			},
+
+			"readiness_check": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Checks run against the cluster API server after the cluster is created or its version is updated. The apply fails with a report of the failing checks if the cluster is not ready within the timeout.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "The number of minutes to wait for the checks to pass.",
+						},
+						"endpoint_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The cluster service endpoint used to connect to the API server, for example private or vpe.",
+						},
+						"nodes_ready": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Check that all nodes have the Ready condition.",
+						},
+						"albs_healthy": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Check that all enabled ALBs of the cluster are healthy.",
+						},
+						"workload": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "DaemonSets and Deployments that must have all their pods ready.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"kind": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{readinessKindDaemonSet, readinessKindDeployment}, false),
+										Description:  "The kind of the workload: DaemonSet or Deployment.",
+									},
+									"namespace": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The namespace of the workload.",
+									},
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The name of the workload.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
 			"service_subnet": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -831,7 +986,9 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 	}
 
-	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version")) && !d.IsNewResource() {
+	versionUpdated := false
+	if (d.HasChange("kube_version") || d.HasChange("update_all_workers") || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || d.HasChange("worker_upgrade_progress")) && !d.IsNewResource() {
+		versionUpdated = true
 
 		if d.HasChange("kube_version") {
 			ClusterClient, err := meta.(conns.ClientSession).ContainerAPI()
@@ -872,78 +1029,14 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 			return err
 		}
 
-		clusterID := d.Id()
-
-		// Update the worker nodes after master node kube-version is updated.
-		// workers will store the existing workers info to identify the replaced node
-		workersInfo := make(map[string]int)
-
 		updateAllWorkers := d.Get("update_all_workers").(bool)
-		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") {
-
-			// patchVersion := d.Get("patch_version").(string)
-			workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
-			if err != nil {
+		if updateAllWorkers || d.HasChange("patch_version") || d.HasChange("retry_patch_version") || d.HasChange("worker_upgrade_progress") {
+			// Update the worker nodes after master node kube-version is updated.
+			ctx, cancel := context.WithTimeout(context.Background(), d.Timeout(schema.TimeoutUpdate))
+			defer cancel()
+			if err := updateVpcClusterWorkers(ctx, d, meta, csClient, targetEnv); err != nil {
 				d.Set("patch_version", nil)
-				return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
-			}
-
-			for index, worker := range workers {
-				workersInfo[worker.ID] = index
-			}
-			workersCount := len(workers)
-
-			waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
-
-			for _, worker := range workers {
-				workerPool, err := csClient.WorkerPools().GetWorkerPool(clusterID, worker.PoolID, targetEnv)
-				if err != nil {
-					return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
-				}
-
-				// check if change is present in MAJOR.MINOR version or in PATCH version
-				if worker.KubeVersion.Actual != worker.KubeVersion.Target || worker.LifeCycle.ActualOperatingSystem != workerPool.OperatingSystem {
-					_, err := csClient.Workers().ReplaceWokerNode(clusterID, worker.ID, targetEnv)
-					// As API returns http response 204 NO CONTENT, error raised will be exempted.
-					if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
-						d.Set("patch_version", nil)
-						return fmt.Errorf("[ERROR] Error replacing the worker node from the cluster: %s", err)
-					}
-
-					if waitForWorkerUpdate {
-						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
-						if deleteError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Worker node - %s is failed to replace", worker.ID)
-						}
-
-						//2. wait for new workerNode
-						_, newWorkerError := waitForNewWorker(d, meta, targetEnv, workersCount)
-						if newWorkerError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Failed to spawn new worker node")
-						}
-
-						//3. Get new worker node ID and update the map
-						newWorkerID, index, newNodeError := getNewWorkerID(d, meta, targetEnv, workersInfo)
-						if newNodeError != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf("[ERROR] Unable to find the new worker node info")
-						}
-
-						delete(workersInfo, worker.ID)
-						workersInfo[newWorkerID] = index
-
-						//4. wait for the worker's version update and normal state
-						_, Err := waitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, newWorkerID)
-						if Err != nil {
-							d.Set("patch_version", nil)
-							return fmt.Errorf(
-								"[ERROR] Error waiting for cluster (%s) worker nodes kube version to be updated: %s", d.Id(), Err)
-						}
-					}
-				}
+				return err
 			}
 		}
 	}
@@ -968,6 +1061,12 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if _, ok := d.GetOk("readiness_check"); ok && (d.IsNewResource() || versionUpdated || d.HasChange("readiness_check")) {
+		if err := checkVpcClusterReadiness(d, meta, csClient, targetEnv); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMContainerVpcClusterRead(d, meta)
 }
 
@@ -1470,7 +1569,7 @@ func vpcClusterVersionRefreshFunc(client v2.Clusters, instanceID string, d *sche
 }
 
 // waitForVpcClusterWokersVersionUpdate Waits for Cluster version Update
-func waitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface{}, target v2.ClusterTargetHeader, workerID string, timeout time.Duration) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1482,7 +1581,7 @@ func waitForVpcClusterWokersVersionUpdate(d *schema.ResourceData, meta interface
 		Pending:                   []string{"retry", versionUpdating},
 		Target:                    []string{workerNormal},
 		Refresh:                   vpcClusterWorkersVersionRefreshFunc(csClient.Workers(), workerID, clusterID, target),
-		Timeout:                   d.Timeout(schema.TimeoutUpdate),
+		Timeout:                   timeout,
 		Delay:                     10 * time.Second,
 		MinTimeout:                10 * time.Second,
 		ContinuousTargetOccurence: 3,
@@ -1505,7 +1604,7 @@ func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID
 	}
 }
 
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string, timeout time.Duration) (interface{}, error) {
 
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -1526,7 +1625,7 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 			}
 			return worker, workerDeletePending, nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Timeout:      timeout,
 		Delay:        10 * time.Second,
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
@@ -1534,7 +1633,7 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 	return deleteStateConf.WaitForState()
 }
 
-func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int) (interface{}, error) {
+func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersCount int, timeout time.Duration) (interface{}, error) {
 	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
@@ -1554,7 +1653,7 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 			}
 			return workers, "creating", nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Timeout:      timeout,
 		Delay:        10 * time.Second,
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
@@ -1562,24 +1661,485 @@ func waitForNewWorker(d *schema.ResourceData, meta interface{}, targetEnv v2.Clu
 	return stateConf.WaitForState()
 }
 
-func getNewWorkerID(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workersInfo map[string]int) (string, int, error) {
-	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
-	if err != nil {
-		return "", -1, err
+const (
+	workerUpgradePending   = "pending"
+	workerUpgradeReplacing = "replacing"
+	workerUpgradeUpdated   = "updated"
+	workerUpgradeFailed    = "failed"
+	workerUpgradeUnknown   = "unknown"
+)
+
+// resourceIBMContainerVpcClusterUpgradeStrategyCustomizeDiff rejects an
+// upgrade_strategy that cannot be applied because the workers are not waited
+// for, and so cannot be replaced in batches.
+func resourceIBMContainerVpcClusterUpgradeStrategyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if strategy, ok := diff.GetOk("upgrade_strategy"); ok && len(strategy.([]interface{})) > 0 && !diff.Get("wait_for_worker_update").(bool) {
+		return fmt.Errorf("[ERROR] upgrade_strategy requires wait_for_worker_update to be true, workers can only be replaced in batches when the update waits for them")
+	}
+	return nil
+}
+
+// resourceIBMContainerVpcClusterWorkerUpgradeCustomizeDiff plans a change of
+// worker_upgrade_progress while a worker update is unfinished, so that the next
+// apply resumes it. Update saves the new kube_version even when the worker
+// update fails, and would otherwise show no diff.
+func resourceIBMContainerVpcClusterWorkerUpgradeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" || !diff.Get("wait_for_worker_update").(bool) {
+		return nil
+	}
+	for _, p := range diff.Get("worker_upgrade_progress").([]interface{}) {
+		switch p.(map[string]interface{})["status"].(string) {
+		case workerUpgradePending, workerUpgradeReplacing, workerUpgradeFailed:
+			return diff.SetNewComputed("worker_upgrade_progress")
+		}
+	}
+	return nil
+}
+
+// vpcClusterUpgradeStrategy limits how many workers are replaced at a time.
+// Its zero configuration replaces one worker at a time and stops on the first
+// failure.
+type vpcClusterUpgradeStrategy struct {
+	maxUnavailablePerPool    int
+	maxUnavailablePerZone    int
+	batchParallelism         int
+	pauseBetweenBatches      time.Duration
+	continueOnErrorThreshold int
+}
+
+type vpcClusterWorkerUpgrade struct {
+	workerID            string
+	poolID              string
+	zone                string
+	status              string
+	replacementWorkerID string
+	message             string
+}
+
+func expandVpcClusterUpgradeStrategy(d *schema.ResourceData) vpcClusterUpgradeStrategy {
+	strategy := vpcClusterUpgradeStrategy{
+		maxUnavailablePerPool: 1,
+		maxUnavailablePerZone: 1,
+		batchParallelism:      1,
+	}
+	if v, ok := d.GetOk("upgrade_strategy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		s := v.([]interface{})[0].(map[string]interface{})
+		strategy.maxUnavailablePerPool = s["max_unavailable_per_pool"].(int)
+		strategy.maxUnavailablePerZone = s["max_unavailable_per_zone"].(int)
+		strategy.batchParallelism = s["batch_parallelism"].(int)
+		strategy.pauseBetweenBatches = time.Duration(s["pause_between_batches"].(int)) * time.Second
+		strategy.continueOnErrorThreshold = s["continue_on_error_threshold"].(int)
+	}
+	return strategy
+}
+
+func flattenVpcClusterWorkerUpgrades(upgrades []*vpcClusterWorkerUpgrade) []map[string]interface{} {
+	progress := make([]map[string]interface{}, 0, len(upgrades))
+	for _, u := range upgrades {
+		progress = append(progress, map[string]interface{}{
+			"worker_id":             u.workerID,
+			"pool_id":               u.poolID,
+			"zone":                  u.zone,
+			"status":                u.status,
+			"replacement_worker_id": u.replacementWorkerID,
+			"message":               u.message,
+		})
+	}
+	return progress
+}
+
+// nextVpcClusterWorkerBatch takes the next batch of workers from pending,
+// keeping within the limits of strategy.
+func nextVpcClusterWorkerBatch(pending []*vpcClusterWorkerUpgrade, strategy vpcClusterUpgradeStrategy) (batch, rest []*vpcClusterWorkerUpgrade) {
+	perPool := map[string]int{}
+	perZone := map[string]int{}
+	for _, u := range pending {
+		if len(batch) < strategy.batchParallelism && perPool[u.poolID] < strategy.maxUnavailablePerPool && perZone[u.zone] < strategy.maxUnavailablePerZone {
+			batch = append(batch, u)
+			perPool[u.poolID]++
+			perZone[u.zone]++
+			continue
+		}
+		rest = append(rest, u)
 	}
+	return batch, rest
+}
 
+// updateVpcClusterWorkers replaces the workers whose kube version or operating
+// system is not the one of their pool, in batches bounded by the
+// upgrade_strategy of the cluster. The progress of each worker is recorded in
+// worker_upgrade_progress, and workers that were still being replaced when a
+// previous apply stopped are waited for instead of being replaced again.
+func updateVpcClusterWorkers(ctx context.Context, d *schema.ResourceData, meta interface{}, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader) error {
 	clusterID := d.Id()
+	strategy := expandVpcClusterUpgradeStrategy(d)
+	waitForWorkerUpdate := d.Get("wait_for_worker_update").(bool)
+
+	resumed := map[string]*vpcClusterWorkerUpgrade{}
+	for _, p := range d.Get("worker_upgrade_progress").([]interface{}) {
+		progress := p.(map[string]interface{})
+		if progress["status"].(string) == workerUpgradeReplacing {
+			resumed[progress["worker_id"].(string)] = &vpcClusterWorkerUpgrade{
+				workerID: progress["worker_id"].(string),
+				poolID:   progress["pool_id"].(string),
+				zone:     progress["zone"].(string),
+				status:   workerUpgradeReplacing,
+			}
+		}
+	}
+
+	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving workers for cluster: %s", err)
+	}
+	workersCount := len(workers)
+	knownWorkers := map[string]bool{}
+	for _, worker := range workers {
+		knownWorkers[worker.ID] = true
+	}
+
+	var upgrades, pending []*vpcClusterWorkerUpgrade
+	for id, u := range resumed {
+		if !knownWorkers[id] {
+			// The worker was deleted before the previous apply stopped, so its
+			// replacement can no longer be told apart from the other workers
+			// and the outcome of the replacement is not known.
+			u.status = workerUpgradeUnknown
+			u.message = "deleted before the previous apply stopped, the replacement worker cannot be identified"
+			upgrades = append(upgrades, u)
+		}
+	}
+	workerPools := map[string]v2.GetWorkerPoolResponse{}
+	for _, worker := range workers {
+		if u, ok := resumed[worker.ID]; ok {
+			upgrades = append(upgrades, u)
+			pending = append(pending, u)
+			continue
+		}
+		workerPool, ok := workerPools[worker.PoolID]
+		if !ok {
+			workerPool, err = csClient.WorkerPools().GetWorkerPool(clusterID, worker.PoolID, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error retrieving worker pool: %s", err)
+			}
+			workerPools[worker.PoolID] = workerPool
+		}
+		// check if change is present in MAJOR.MINOR version or in PATCH version
+		if worker.KubeVersion.Actual != worker.KubeVersion.Target || worker.LifeCycle.ActualOperatingSystem != workerPool.OperatingSystem {
+			u := &vpcClusterWorkerUpgrade{
+				workerID: worker.ID,
+				poolID:   worker.PoolID,
+				zone:     worker.Location,
+				status:   workerUpgradePending,
+			}
+			upgrades = append(upgrades, u)
+			pending = append(pending, u)
+		}
+	}
+	// Keep the order of the workers stable across applies
+	sort.SliceStable(upgrades, func(i, j int) bool { return upgrades[i].workerID < upgrades[j].workerID })
+	sort.SliceStable(pending, func(i, j int) bool { return pending[i].workerID < pending[j].workerID })
+	d.Set("worker_upgrade_progress", flattenVpcClusterWorkerUpgrades(upgrades))
+
+	if !waitForWorkerUpdate {
+		// Without waiting there is nothing to bound a batch by, so every worker
+		// is replaced at once. CustomizeDiff rejects an upgrade_strategy here.
+		var replaceErrors []string
+		for _, u := range pending {
+			if u.status == workerUpgradePending {
+				replaceVpcClusterWorker(csClient, clusterID, targetEnv, u)
+				if u.status == workerUpgradeFailed {
+					replaceErrors = append(replaceErrors, fmt.Sprintf("%s: %s", u.workerID, u.message))
+				}
+			}
+		}
+		d.Set("worker_upgrade_progress", flattenVpcClusterWorkerUpgrades(upgrades))
+		if len(replaceErrors) > 0 {
+			return fmt.Errorf("[ERROR] Error replacing the worker nodes from the cluster: %s", strings.Join(replaceErrors, "; "))
+		}
+		return nil
+	}
+
+	// remaining is the time left until the update times out
+	remaining := func() time.Duration {
+		if deadline, ok := ctx.Deadline(); ok {
+			return time.Until(deadline)
+		}
+		return d.Timeout(schema.TimeoutUpdate)
+	}
+
+	failures := 0
+	for len(pending) > 0 {
+		if remaining() <= 0 {
+			return fmt.Errorf("[ERROR] Timed out updating the worker nodes of cluster (%s), see worker_upgrade_progress", clusterID)
+		}
+		var batch []*vpcClusterWorkerUpgrade
+		batch, pending = nextVpcClusterWorkerBatch(pending, strategy)
+
+		//1. replace the workers of the batch and wait for them to delete
+		for _, u := range batch {
+			if u.status == workerUpgradePending {
+				replaceVpcClusterWorker(csClient, clusterID, targetEnv, u)
+			}
+		}
+		d.Set("worker_upgrade_progress", flattenVpcClusterWorkerUpgrades(upgrades))
+		for _, u := range batch {
+			if u.status != workerUpgradeReplacing {
+				continue
+			}
+			if _, err := waitForWorkerNodetoDelete(d, meta, targetEnv, u.workerID, remaining()); err != nil {
+				u.status, u.message = workerUpgradeFailed, fmt.Sprintf("worker node failed to be deleted: %s", err)
+			}
+		}
+
+		//2. wait for the new workers and match them to the replaced ones
+		if _, err := waitForNewWorker(d, meta, targetEnv, workersCount, remaining()); err != nil {
+			for _, u := range batch {
+				if u.status == workerUpgradeReplacing {
+					u.status, u.message = workerUpgradeFailed, "failed to spawn new worker node"
+				}
+			}
+		} else if err := matchVpcClusterReplacementWorkers(csClient, clusterID, targetEnv, batch, knownWorkers); err != nil {
+			return err
+		}
+
+		//3. wait for the new workers' version update and normal state
+		for _, u := range batch {
+			if u.status != workerUpgradeReplacing {
+				continue
+			}
+			if _, err := waitForVpcClusterWokersVersionUpdate(d, meta, targetEnv, u.replacementWorkerID, remaining()); err != nil {
+				u.status, u.message = workerUpgradeFailed, fmt.Sprintf("error waiting for worker node kube version to be updated: %s", err)
+				continue
+			}
+			u.status = workerUpgradeUpdated
+		}
+		d.Set("worker_upgrade_progress", flattenVpcClusterWorkerUpgrades(upgrades))
+
+		for _, u := range batch {
+			if u.status == workerUpgradeFailed {
+				failures++
+				log.Printf("[WARN] Worker node %s of cluster %s failed to be replaced: %s", u.workerID, clusterID, u.message)
+			}
+		}
+		if failures > strategy.continueOnErrorThreshold {
+			return fmt.Errorf("[ERROR] %d worker nodes of cluster (%s) failed to be replaced, see worker_upgrade_progress", failures, clusterID)
+		}
+
+		if len(pending) > 0 && strategy.pauseBetweenBatches > 0 {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("[ERROR] Timed out updating the worker nodes of cluster (%s), see worker_upgrade_progress: %s", clusterID, ctx.Err())
+			case <-time.After(strategy.pauseBetweenBatches):
+			}
+		}
+	}
+	return nil
+}
 
+// replaceVpcClusterWorker starts the replacement of the worker of u and records
+// the outcome in u.
+func replaceVpcClusterWorker(csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader, u *vpcClusterWorkerUpgrade) {
+	_, err := csClient.Workers().ReplaceWokerNode(clusterID, u.workerID, targetEnv)
+	// As API returns http response 204 NO CONTENT, error raised will be exempted.
+	if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+		u.status, u.message = workerUpgradeFailed, fmt.Sprintf("error replacing the worker node: %s", err)
+		return
+	}
+	u.status = workerUpgradeReplacing
+}
+
+// matchVpcClusterReplacementWorkers records, for each worker of batch that is
+// being replaced, the new worker that replaced it, preferring a new worker in
+// the same pool and zone.
+func matchVpcClusterReplacementWorkers(csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader, batch []*vpcClusterWorkerUpgrade, knownWorkers map[string]bool) error {
 	workers, err := csClient.Workers().ListWorkers(clusterID, false, targetEnv)
 	if err != nil {
-		return "", -1, fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
+		return fmt.Errorf("[ERROR] Error in retriving the list of worker nodes")
+	}
+	var newWorkers []v2.Worker
+	for _, worker := range workers {
+		if !knownWorkers[worker.ID] {
+			newWorkers = append(newWorkers, worker)
+		}
+	}
+
+	take := func(match func(v2.Worker) bool) string {
+		for i, worker := range newWorkers {
+			if match(worker) {
+				newWorkers = append(newWorkers[:i], newWorkers[i+1:]...)
+				knownWorkers[worker.ID] = true
+				return worker.ID
+			}
+		}
+		return ""
+	}
+	for _, u := range batch {
+		if u.status != workerUpgradeReplacing {
+			continue
+		}
+		u.replacementWorkerID = take(func(w v2.Worker) bool { return w.PoolID == u.poolID && w.Location == u.zone })
+		if u.replacementWorkerID == "" {
+			u.replacementWorkerID = take(func(w v2.Worker) bool { return w.PoolID == u.poolID })
+		}
+		if u.replacementWorkerID == "" {
+			u.status, u.message = workerUpgradeFailed, "unable to find the new worker node"
+			continue
+		}
+		log.Println("found new replaced node: ", u.replacementWorkerID)
+	}
+	return nil
+}
+
+const (
+	readinessKindDaemonSet  = "DaemonSet"
+	readinessKindDeployment = "Deployment"
+	albStatusHealthy        = "healthy"
+)
+
+// checkVpcClusterReadiness connects to the cluster API server with the IAM
+// token of the user and waits until all configured readiness checks pass.
+func checkVpcClusterReadiness(d *schema.ResourceData, meta interface{}, csClient v2.ContainerServiceAPI, targetEnv v2.ClusterTargetHeader) error {
+	check := d.Get("readiness_check").([]interface{})[0].(map[string]interface{})
+	clusterID := d.Id()
+	timeout := time.Duration(check["timeout"].(int)) * time.Minute
+	endpointType := check["endpoint_type"].(string)
+
+	var clientset *k8s.Clientset
+	var report []string
+	err := resource.Retry(timeout, func() *resource.RetryError {
+		if clientset == nil {
+			var err error
+			clientset, err = newVpcClusterClientset(csClient, clusterID, targetEnv, endpointType)
+			if err != nil {
+				report = []string{fmt.Sprintf("cannot connect to the cluster API server: %s", err)}
+				return resource.RetryableError(err)
+			}
+		}
+		report = vpcClusterReadinessReport(clientset, csClient, clusterID, targetEnv, check)
+		if len(report) > 0 {
+			log.Printf("[DEBUG] Cluster (%s) is not ready yet: %s", clusterID, strings.Join(report, "; "))
+			return resource.RetryableError(fmt.Errorf("%d readiness checks failed", len(report)))
+		}
+		return nil
+	})
+	if err != nil {
+		if !conns.IsResourceTimeoutError(err) {
+			return fmt.Errorf("[ERROR] Error checking readiness of cluster (%s): %s", clusterID, err)
+		}
+		return fmt.Errorf("[ERROR] Cluster (%s) is not ready after %s:\n  - %s", clusterID, timeout, strings.Join(report, "\n  - "))
+	}
+	return nil
+}
+
+func newVpcClusterClientset(csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader, endpointType string) (*k8s.Clientset, error) {
+	details, err := fetchClusterConfigInMemory(csClient, clusterID, false, targetEnv, endpointType)
+	if err != nil {
+		return nil, err
+	}
+	config := &rest.Config{
+		Host:        details.host,
+		BearerToken: details.token,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: []byte(details.caCertificate),
+		},
+		Timeout: time.Minute,
+	}
+	return k8s.NewForConfig(config)
+}
+
+// vpcClusterReadinessReport returns a line for every readiness check that
+// does not pass.
+func vpcClusterReadinessReport(clientset *k8s.Clientset, csClient v2.ContainerServiceAPI, clusterID string, targetEnv v2.ClusterTargetHeader, check map[string]interface{}) []string {
+	ctx := context.Background()
+	report := []string{}
+
+	if check["nodes_ready"].(bool) {
+		nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		switch {
+		case err != nil:
+			report = append(report, fmt.Sprintf("failed to list nodes: %s", err))
+		case len(nodes.Items) == 0:
+			report = append(report, "no nodes are registered")
+		default:
+			for _, node := range nodes.Items {
+				if msg := nodeNotReadyMessage(node); msg != "" {
+					report = append(report, fmt.Sprintf("node %s is not Ready: %s", node.Name, msg))
+				}
+			}
+		}
+	}
+
+	if workloads, ok := check["workload"].([]interface{}); ok {
+		for _, w := range workloads {
+			workload := w.(map[string]interface{})
+			kind := workload["kind"].(string)
+			namespace := workload["namespace"].(string)
+			name := workload["name"].(string)
+			if msg := workloadNotReadyMessage(ctx, clientset, kind, namespace, name); msg != "" {
+				report = append(report, fmt.Sprintf("%s %s/%s %s", kind, namespace, name, msg))
+			}
+		}
+	}
+
+	if check["albs_healthy"].(bool) {
+		albs, err := csClient.Albs().ListClusterAlbs(clusterID, targetEnv)
+		if err != nil {
+			report = append(report, fmt.Sprintf("failed to list ALBs: %s", err))
+		}
+		for _, alb := range albs {
+			if alb.Enable && alb.Status != albStatusHealthy {
+				report = append(report, fmt.Sprintf("ALB %s is %s (state: %s)", alb.AlbID, alb.Status, alb.State))
+			}
+		}
+	}
+
+	return report
+}
+
+func nodeNotReadyMessage(node corev1.Node) string {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type != corev1.NodeReady {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return ""
+		}
+		return fmt.Sprintf("%s %s", condition.Reason, condition.Message)
 	}
+	return "no Ready condition reported"
+}
 
-	for index, worker := range workers {
-		if _, ok := workersInfo[worker.ID]; !ok {
-			log.Println("found new replaced node: ", worker.ID)
-			return worker.ID, index, nil
+func workloadNotReadyMessage(ctx context.Context, clientset *k8s.Clientset, kind, namespace, name string) string {
+	switch kind {
+	case readinessKindDaemonSet:
+		ds, err := clientset.AppsV1().DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return workloadErrorMessage(err)
+		}
+		desired := ds.Status.DesiredNumberScheduled
+		if desired == 0 || ds.Status.NumberReady < desired || ds.Status.UpdatedNumberScheduled < desired {
+			return fmt.Sprintf("has %d of %d pods ready and %d updated", ds.Status.NumberReady, desired, ds.Status.UpdatedNumberScheduled)
 		}
+	case readinessKindDeployment:
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return workloadErrorMessage(err)
+		}
+		desired := int32(1)
+		if deployment.Spec.Replicas != nil {
+			desired = *deployment.Spec.Replicas
+		}
+		if deployment.Status.AvailableReplicas < desired || deployment.Status.UpdatedReplicas < desired {
+			return fmt.Sprintf("has %d of %d replicas available and %d updated", deployment.Status.AvailableReplicas, desired, deployment.Status.UpdatedReplicas)
+		}
+	}
+	return ""
+}
+
+func workloadErrorMessage(err error) string {
+	if k8serrors.IsNotFound(err) {
+		return "was not found"
 	}
-	return "", -1, fmt.Errorf("[ERROR] no new node found")
+	return fmt.Sprintf("could not be read: %s", err)
 }
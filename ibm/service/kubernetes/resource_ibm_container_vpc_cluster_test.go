@@ -387,6 +387,66 @@ func TestAccIBMContainerVPCClusterEnableSecureByDefault(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerVpcClusterUpgradeStrategy(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterUpgradeStrategy(name, acc.KubeVersion, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcClusterExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_count", "2"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerVpcClusterUpgradeStrategy(name, acc.KubeUpdateVersion, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcClusterExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "upgrade_strategy.0.batch_parallelism", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_upgrade_progress.#", "4"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "worker_upgrade_progress.0.status", "updated"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_vpc_cluster.cluster", "worker_upgrade_progress.0.replacement_worker_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerVpcClusterReadinessCheck(t *testing.T) {
+	name := fmt.Sprintf("tf-vpc-cluster-%d", acctest.RandIntRange(10, 100))
+	var conf *v2.ClusterInfo
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerVpcClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerVpcClusterReadinessCheck(name, "coredns"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMContainerVpcClusterExists("ibm_container_vpc_cluster.cluster", conf),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_cluster.cluster", "readiness_check.0.workload.#", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMContainerVpcClusterReadinessCheck(name, "tf-missing-deployment"),
+				ExpectError: regexp.MustCompile("Deployment kube-system/tf-missing-deployment was not found"),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMContainerVpcClusterDestroy(s *terraform.State) error {
 	csClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).VpcContainerAPI()
 	if err != nil {
@@ -571,6 +631,53 @@ resource "ibm_container_vpc_cluster" "cluster" {
   }`, name, region)
 }
 
+func testAccCheckIBMContainerVpcClusterUpgradeStrategy(name, kubeVersion string, updateAllWorkers bool) string {
+	region := acc.Region()
+	return fmt.Sprintf(`
+data "ibm_resource_group" "resource_group" {
+	is_default = "true"
+}
+resource "ibm_is_vpc" "vpc" {
+	name = "%[1]s"
+}
+resource "ibm_is_subnet" "subnet" {
+	name                     = "%[1]s"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "%[2]s-1"
+	total_ipv4_address_count = 256
+}
+resource "ibm_is_subnet" "subnet2" {
+	name                     = "%[1]s-2"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "%[2]s-2"
+	total_ipv4_address_count = 256
+}
+resource "ibm_container_vpc_cluster" "cluster" {
+	name               = "%[1]s"
+	vpc_id             = ibm_is_vpc.vpc.id
+	flavor             = "cx2.2x4"
+	worker_count       = 2
+	kube_version       = "%[3]s"
+	update_all_workers = %[4]t
+	wait_till          = "OneWorkerNodeReady"
+	resource_group_id  = data.ibm_resource_group.resource_group.id
+	zones {
+		subnet_id = ibm_is_subnet.subnet.id
+		name      = "%[2]s-1"
+	}
+	zones {
+		subnet_id = ibm_is_subnet.subnet2.id
+		name      = "%[2]s-2"
+	}
+	upgrade_strategy {
+		max_unavailable_per_pool = 2
+		max_unavailable_per_zone = 1
+		batch_parallelism        = 2
+		pause_between_batches    = 30
+	}
+}`, name, region, kubeVersion, updateAllWorkers)
+}
+
 func testAccCheckIBMContainerVpcClusterDisableOutboundTrafficProtection(name, kubeVersion, disable_outbound_traffic_protection string) string {
 	return fmt.Sprintf(`
 data "ibm_resource_group" "resource_group" {
@@ -1179,3 +1286,45 @@ resource "ibm_container_vpc_cluster" "cluster" {
 	%[3]s
 }`, name, region, networkPluginConfig)
 }
+
+func testAccCheckIBMContainerVpcClusterReadinessCheck(name, deployment string) string {
+	return fmt.Sprintf(`
+data "ibm_resource_group" "resource_group" {
+	is_default = "true"
+}
+resource "ibm_is_vpc" "vpc" {
+	name = "%[1]s"
+}
+resource "ibm_is_subnet" "subnet" {
+	name                     = "%[1]s"
+	vpc                      = ibm_is_vpc.vpc.id
+	zone                     = "us-south-1"
+	total_ipv4_address_count = 256
+}
+resource "ibm_container_vpc_cluster" "cluster" {
+	name              = "%[1]s"
+	vpc_id            = ibm_is_vpc.vpc.id
+	flavor            = "cx2.2x4"
+	worker_count      = 1
+	wait_till         = "IngressReady"
+	resource_group_id = data.ibm_resource_group.resource_group.id
+	zones {
+		subnet_id = ibm_is_subnet.subnet.id
+		name      = "us-south-1"
+	}
+	readiness_check {
+		timeout      = 10
+		albs_healthy = true
+		workload {
+			kind      = "DaemonSet"
+			namespace = "calico-system"
+			name      = "calico-node"
+		}
+		workload {
+			kind      = "Deployment"
+			namespace = "kube-system"
+			name      = "%[2]s"
+		}
+	}
+}`, name, deployment)
+}
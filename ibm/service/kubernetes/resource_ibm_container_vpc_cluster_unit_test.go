@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testVpcClusterWorkerIDs(upgrades []*vpcClusterWorkerUpgrade) []string {
+	ids := []string{}
+	for _, u := range upgrades {
+		ids = append(ids, u.workerID)
+	}
+	return ids
+}
+
+func TestNextVpcClusterWorkerBatch(t *testing.T) {
+	pending := []*vpcClusterWorkerUpgrade{
+		{workerID: "w1", poolID: "default", zone: "us-south-1"},
+		{workerID: "w2", poolID: "default", zone: "us-south-1"},
+		{workerID: "w3", poolID: "default", zone: "us-south-2"},
+		{workerID: "w4", poolID: "gpu", zone: "us-south-1"},
+		{workerID: "w5", poolID: "gpu", zone: "us-south-3"},
+	}
+
+	cases := map[string]struct {
+		strategy vpcClusterUpgradeStrategy
+		batch    []string
+		rest     []string
+	}{
+		"one at a time": {
+			strategy: vpcClusterUpgradeStrategy{maxUnavailablePerPool: 1, maxUnavailablePerZone: 1, batchParallelism: 1},
+			batch:    []string{"w1"},
+			rest:     []string{"w2", "w3", "w4", "w5"},
+		},
+		"one per pool and zone": {
+			strategy: vpcClusterUpgradeStrategy{maxUnavailablePerPool: 1, maxUnavailablePerZone: 1, batchParallelism: 10},
+			batch:    []string{"w1", "w5"},
+			rest:     []string{"w2", "w3", "w4"},
+		},
+		"two per pool": {
+			strategy: vpcClusterUpgradeStrategy{maxUnavailablePerPool: 2, maxUnavailablePerZone: 1, batchParallelism: 10},
+			batch:    []string{"w1", "w3", "w5"},
+			rest:     []string{"w2", "w4"},
+		},
+		"bounded by parallelism": {
+			strategy: vpcClusterUpgradeStrategy{maxUnavailablePerPool: 5, maxUnavailablePerZone: 5, batchParallelism: 3},
+			batch:    []string{"w1", "w2", "w3"},
+			rest:     []string{"w4", "w5"},
+		},
+		"all at once": {
+			strategy: vpcClusterUpgradeStrategy{maxUnavailablePerPool: 5, maxUnavailablePerZone: 5, batchParallelism: 5},
+			batch:    []string{"w1", "w2", "w3", "w4", "w5"},
+			rest:     []string{},
+		},
+	}
+
+	for name, c := range cases {
+		batch, rest := nextVpcClusterWorkerBatch(pending, c.strategy)
+		if got := testVpcClusterWorkerIDs(batch); !reflect.DeepEqual(got, c.batch) {
+			t.Errorf("%s: got batch %v, want %v", name, got, c.batch)
+		}
+		if got := testVpcClusterWorkerIDs(rest); !reflect.DeepEqual(got, c.rest) {
+			t.Errorf("%s: got rest %v, want %v", name, got, c.rest)
+		}
+	}
+
+	if batch, rest := nextVpcClusterWorkerBatch(nil, vpcClusterUpgradeStrategy{maxUnavailablePerPool: 1, maxUnavailablePerZone: 1, batchParallelism: 1}); len(batch) != 0 || len(rest) != 0 {
+		t.Errorf("got batch %v and rest %v for no pending workers", batch, rest)
+	}
+}
@@ -0,0 +1,290 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	k8sretry "k8s.io/client-go/util/retry"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+const (
+	autoscalerConfigMapName      = "iks-ca-configmap"
+	autoscalerConfigMapNamespace = "kube-system"
+	autoscalerWorkerPoolsKey     = "workerPoolsConfig.json"
+)
+
+func ResourceIBMContainerWorkerPoolAutoscaling() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceIBMContainerWorkerPoolAutoscalingCreate,
+		Read:          resourceIBMContainerWorkerPoolAutoscalingRead,
+		Update:        resourceIBMContainerWorkerPoolAutoscalingUpdate,
+		Delete:        resourceIBMContainerWorkerPoolAutoscalingDelete,
+		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMContainerWorkerPoolAutoscalingCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the cluster.",
+			},
+			"worker_pool_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the worker pool.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"endpoint_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The cluster service endpoint used to connect to the API server, for example private or vpe.",
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "The minimum number of worker nodes per zone.",
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of worker nodes per zone.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the cluster autoscaler scales the worker pool.",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	minSize := d.Get("min_size").(int)
+	maxSize := d.Get("max_size").(int)
+	if d.NewValueKnown("min_size") && d.NewValueKnown("max_size") && minSize > maxSize {
+		return fmt.Errorf("[ERROR] min_size (%d) must not be greater than max_size (%d)", minSize, maxSize)
+	}
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	workerPoolName := d.Get("worker_pool_name").(string)
+
+	err := updateWorkerPoolAutoscalerConfig(d, meta, cluster, workerPoolName, func(pool map[string]interface{}) {
+		setWorkerPoolAutoscalerConfig(d, pool)
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cluster, workerPoolName))
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("[ERROR] Incorrect ID %s: ID should be a combination of cluster/workerPoolName", d.Id())
+	}
+	cluster := parts[0]
+	workerPoolName := parts[1]
+
+	clientset, err := workerPoolAutoscalerClientset(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	configMap, err := clientset.CoreV1().ConfigMaps(autoscalerConfigMapNamespace).Get(context.Background(), autoscalerConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			log.Printf("[WARN] The cluster autoscaler ConfigMap of cluster (%s) was not found, removing worker pool autoscaling (%s) from state", cluster, d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving the cluster autoscaler ConfigMap of cluster (%s): %s", cluster, err)
+	}
+
+	pools, err := parseWorkerPoolsAutoscalerConfig(configMap.Data[autoscalerWorkerPoolsKey])
+	if err != nil {
+		return err
+	}
+	pool := findWorkerPoolAutoscalerConfig(pools, workerPoolName)
+	if pool == nil {
+		log.Printf("[WARN] Worker pool (%s) is not in the cluster autoscaler ConfigMap, removing it from state", workerPoolName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("worker_pool_name", workerPoolName)
+	if v, ok := autoscalerConfigInt(pool["minSize"]); ok {
+		d.Set("min_size", v)
+	}
+	if v, ok := autoscalerConfigInt(pool["maxSize"]); ok {
+		d.Set("max_size", v)
+	}
+	if v, ok := pool["enabled"].(bool); ok {
+		d.Set("enabled", v)
+	}
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("min_size") || d.HasChange("max_size") || d.HasChange("enabled") {
+		cluster := d.Get("cluster").(string)
+		workerPoolName := d.Get("worker_pool_name").(string)
+		err := updateWorkerPoolAutoscalerConfig(d, meta, cluster, workerPoolName, func(pool map[string]interface{}) {
+			setWorkerPoolAutoscalerConfig(d, pool)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+// resourceIBMContainerWorkerPoolAutoscalingDelete disables autoscaling of the
+// worker pool. The entry is kept so the autoscaler leaves the pool size as is.
+func resourceIBMContainerWorkerPoolAutoscalingDelete(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	workerPoolName := d.Get("worker_pool_name").(string)
+	err := updateWorkerPoolAutoscalerConfig(d, meta, cluster, workerPoolName, func(pool map[string]interface{}) {
+		pool["enabled"] = false
+	})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func workerPoolAutoscalerClientset(d *schema.ResourceData, meta interface{}, cluster string) (*k8s.Clientset, error) {
+	csClient, err := meta.(conns.ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := newVpcClusterClientset(csClient, cluster, targetEnv, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error connecting to the API server of cluster (%s): %s", cluster, err)
+	}
+	return clientset, nil
+}
+
+// updateWorkerPoolAutoscalerConfig applies update to the section of the worker
+// pool in the cluster autoscaler ConfigMap and leaves the other pools as they are.
+func updateWorkerPoolAutoscalerConfig(d *schema.ResourceData, meta interface{}, cluster, workerPoolName string, update func(pool map[string]interface{})) error {
+	lockKey := "autoscaler_" + cluster
+	conns.IbmMutexKV.Lock(lockKey)
+	defer conns.IbmMutexKV.Unlock(lockKey)
+
+	clientset, err := workerPoolAutoscalerClientset(d, meta, cluster)
+	if err != nil {
+		return err
+	}
+	configMaps := clientset.CoreV1().ConfigMaps(autoscalerConfigMapNamespace)
+
+	err = k8sretry.RetryOnConflict(k8sretry.DefaultRetry, func() error {
+		configMap, err := configMaps.Get(context.Background(), autoscalerConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		pools, err := parseWorkerPoolsAutoscalerConfig(configMap.Data[autoscalerWorkerPoolsKey])
+		if err != nil {
+			return err
+		}
+		pool := findWorkerPoolAutoscalerConfig(pools, workerPoolName)
+		if pool == nil {
+			pool = map[string]interface{}{"name": workerPoolName}
+			pools = append(pools, pool)
+		}
+		update(pool)
+
+		data, err := json.Marshal(pools)
+		if err != nil {
+			return err
+		}
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[autoscalerWorkerPoolsKey] = string(data)
+		_, err = configMaps.Update(context.Background(), configMap, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return fmt.Errorf("[ERROR] The cluster autoscaler ConfigMap %s/%s of cluster (%s) was not found. Install the cluster-autoscaler add-on with the ibm_container_addons resource first: %w", autoscalerConfigMapNamespace, autoscalerConfigMapName, cluster, err)
+		}
+		return fmt.Errorf("[ERROR] Error updating the cluster autoscaler configuration of worker pool (%s) in cluster (%s): %s", workerPoolName, cluster, err)
+	}
+	return nil
+}
+
+func setWorkerPoolAutoscalerConfig(d *schema.ResourceData, pool map[string]interface{}) {
+	pool["minSize"] = d.Get("min_size").(int)
+	pool["maxSize"] = d.Get("max_size").(int)
+	pool["enabled"] = d.Get("enabled").(bool)
+}
+
+func parseWorkerPoolsAutoscalerConfig(config string) ([]map[string]interface{}, error) {
+	pools := []map[string]interface{}{}
+	if config == "" {
+		return pools, nil
+	}
+	if err := json.Unmarshal([]byte(config), &pools); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing %s of the cluster autoscaler ConfigMap: %s", autoscalerWorkerPoolsKey, err)
+	}
+	return pools, nil
+}
+
+func findWorkerPoolAutoscalerConfig(pools []map[string]interface{}, workerPoolName string) map[string]interface{} {
+	for _, pool := range pools {
+		if name, ok := pool["name"].(string); ok && name == workerPoolName {
+			return pool
+		}
+	}
+	return nil
+}
+
+func autoscalerConfigInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case float64:
+		return int(n), true
+	case int:
+		return n, true
+	}
+	return 0, false
+}
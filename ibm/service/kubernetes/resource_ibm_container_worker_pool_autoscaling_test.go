@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kubernetes_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerWorkerPoolAutoscalingBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-wp-autoscaling-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name, 1, 2, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "min_size", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "max_size", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name, 1, 3, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "max_size", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_worker_pool_autoscaling.autoscaling",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_group_id"},
+			},
+		},
+	})
+}
+
+func TestAccIBMContainerWorkerPoolAutoscalingInvalidSize(t *testing.T) {
+	name := fmt.Sprintf("tf-wp-autoscaling-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name, 3, 2, true),
+				ExpectError: regexp.MustCompile("min_size \\(3\\) must not be greater than max_size \\(2\\)"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name string, minSize, maxSize int, enabled bool) string {
+	return fmt.Sprintf(`
+data "ibm_resource_group" "resource_group" {
+	is_default = true
+}
+
+resource "ibm_container_vpc_cluster" "cluster" {
+	name              = "%[1]s"
+	vpc_id            = "%[2]s"
+	flavor            = "cx2.2x4"
+	worker_count      = 1
+	resource_group_id = data.ibm_resource_group.resource_group.id
+	wait_till         = "OneWorkerNodeReady"
+	zones {
+		subnet_id = "%[3]s"
+		name      = "us-south-1"
+	}
+}
+
+resource "ibm_container_vpc_worker_pool" "pool" {
+	cluster           = ibm_container_vpc_cluster.cluster.id
+	worker_pool_name  = "%[1]s-wp"
+	flavor            = "cx2.2x4"
+	vpc_id            = "%[2]s"
+	worker_count      = 1
+	resource_group_id = data.ibm_resource_group.resource_group.id
+	zones {
+		name      = "us-south-1"
+		subnet_id = "%[3]s"
+	}
+}
+
+resource "ibm_container_addons" "addons" {
+	cluster = ibm_container_vpc_cluster.cluster.id
+	addons {
+		name = "cluster-autoscaler"
+	}
+}
+
+resource "ibm_container_worker_pool_autoscaling" "autoscaling" {
+	cluster           = ibm_container_vpc_cluster.cluster.id
+	worker_pool_name  = ibm_container_vpc_worker_pool.pool.worker_pool_name
+	resource_group_id = data.ibm_resource_group.resource_group.id
+	min_size          = %[4]d
+	max_size          = %[5]d
+	enabled           = %[6]t
+	depends_on        = [ibm_container_addons.addons]
+}`, name, acc.IksClusterVpcID, acc.IksClusterSubnetID, minSize, maxSize, enabled)
+}
@@ -0,0 +1,579 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+const (
+	remoteCopyStateConsistentCopying      = "consistent_copying"
+	remoteCopyStateConsistentStopped      = "consistent_stopped"
+	remoteCopyStateConsistentSynchronized = "consistent_synchronized"
+	remoteCopyStateIdling                 = "idling"
+
+	disasterRecoveryFailoverDefaultTimeout = 120 * time.Minute
+)
+
+var (
+	_ action.Action              = &piDisasterRecoveryFailoverAction{}
+	_ action.ActionWithConfigure = &piDisasterRecoveryFailoverAction{}
+)
+
+func NewPIDisasterRecoveryFailoverAction() action.Action {
+	return &piDisasterRecoveryFailoverAction{}
+}
+
+type piDisasterRecoveryFailoverAction struct {
+	session conns.ClientSession
+}
+
+type disasterRecoveryFailoverModel struct {
+	CloudInstanceID       types.String                            `tfsdk:"pi_cloud_instance_id"`
+	Zone                  types.String                            `tfsdk:"pi_zone"`
+	TargetCloudInstanceID types.String                            `tfsdk:"pi_target_cloud_instance_id"`
+	TargetZone            types.String                            `tfsdk:"pi_target_zone"`
+	VolumeGroupIDs        []types.String                          `tfsdk:"pi_volume_group_ids"`
+	Instances             []disasterRecoveryFailoverInstanceModel `tfsdk:"pi_instances"`
+	DryRun                types.Bool                              `tfsdk:"pi_dry_run"`
+	Timeout               types.Int64                             `tfsdk:"pi_timeout"`
+}
+
+type disasterRecoveryFailoverInstanceModel struct {
+	InstanceID      types.String   `tfsdk:"pi_instance_id"`
+	BootVolumeName  types.String   `tfsdk:"pi_boot_volume_name"`
+	DataVolumeNames []types.String `tfsdk:"pi_data_volume_names"`
+}
+
+// DisasterRecoveryVolumeGroup is a replicated volume group in the primary
+// workspace together with its volumes and remote copy relationships.
+type DisasterRecoveryVolumeGroup struct {
+	ID            string
+	Volumes       []*models.Volume
+	Relationships []*models.RemoteCopyRelationship
+}
+
+// DisasterRecoveryFailoverInstance is a replacement instance in the target
+// workspace and the display names of the volumes it boots from and attaches.
+type DisasterRecoveryFailoverInstance struct {
+	InstanceID      string
+	BootVolumeName  string
+	DataVolumeNames []string
+}
+
+// DisasterRecoveryFailoverPlan is the work a failover has left to do.
+type DisasterRecoveryFailoverPlan struct {
+	// StopVolumeGroupIDs are the volume groups that still replicate.
+	StopVolumeGroupIDs []string
+	// Onboard are the auxiliary volumes that are not yet in the target workspace.
+	Onboard []*models.AuxiliaryVolumeForOnboarding
+	// AuxVolumeNames are the auxiliary volumes, the replication targets, by the
+	// display name of their primary volume.
+	AuxVolumeNames map[string]string
+	// Instances are the replacement instances to boot.
+	Instances []DisasterRecoveryFailoverInstance
+}
+
+func (a *piDisasterRecoveryFailoverAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_pi_disaster_recovery_failover"
+}
+
+func (a *piDisasterRecoveryFailoverAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fails over replicated volume groups to a disaster recovery workspace and boots replacement instances from the auxiliary volumes. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			Arg_CloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the primary workspace that owns the replicated volume groups.",
+			},
+			Arg_Zone: schema.StringAttribute{
+				Optional:    true,
+				Description: "The zone of the primary workspace. Defaults to the provider zone.",
+			},
+			Arg_TargetCloudInstanceID: schema.StringAttribute{
+				Required:    true,
+				Description: "The GUID of the disaster recovery workspace to fail over to.",
+			},
+			Arg_TargetZone: schema.StringAttribute{
+				Optional:    true,
+				Description: "The zone of the disaster recovery workspace. Defaults to the provider zone.",
+			},
+			Arg_VolumeGroupIDs: schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the replicated volume groups in the primary workspace to fail over.",
+			},
+			Arg_Instances: schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "The replacement instances in the disaster recovery workspace. Each instance must already exist; it is shut off, given the onboarded volumes and started.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						Arg_InstanceID: schema.StringAttribute{
+							Required:    true,
+							Description: "The ID of the replacement instance in the disaster recovery workspace.",
+						},
+						Arg_BootVolumeName: schema.StringAttribute{
+							Required:    true,
+							Description: "The name of the replicated volume to boot the instance from.",
+						},
+						Arg_DataVolumeNames: schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The names of the replicated volumes to attach to the instance.",
+						},
+					},
+				},
+			},
+			Arg_DryRun: schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action validates the failover and reports the steps it would take without changing anything. Default: false",
+			},
+			Arg_Timeout: schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in minutes for the whole failover. If not specified, defaults to 120 minutes.",
+			},
+		},
+	}
+}
+
+func (a *piDisasterRecoveryFailoverAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. The provider client session could not be established.", req.ProviderData),
+		)
+		return
+	}
+
+	a.session = session
+}
+
+func (a *piDisasterRecoveryFailoverAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config disasterRecoveryFailoverModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cloudInstanceID := config.CloudInstanceID.ValueString()
+	targetCloudInstanceID := config.TargetCloudInstanceID.ValueString()
+	dryRun := !config.DryRun.IsNull() && config.DryRun.ValueBool()
+
+	timeout := disasterRecoveryFailoverDefaultTimeout
+	if !config.Timeout.IsNull() {
+		timeout = time.Duration(config.Timeout.ValueInt64()) * time.Minute
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	sess, err := a.session.IBMPISessionForZone(config.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Power Systems Session", "Primary workspace session error: "+err.Error())
+		return
+	}
+	targetSess, err := a.session.IBMPISessionForZone(config.TargetZone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Create Power Systems Session", "Disaster recovery workspace session error: "+err.Error())
+		return
+	}
+
+	progress := func(format string, args ...interface{}) {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf(format, args...)})
+	}
+
+	// Validate and plan the failover
+	progress("Inspecting %d volume group(s) in workspace '%s'...", len(config.VolumeGroupIDs), cloudInstanceID)
+	workspace, err := instance.NewIBMPIWorkspacesClient(ctx, sess, cloudInstanceID).Get(cloudInstanceID)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Get Primary Workspace", fmt.Sprintf("Failed to get workspace '%s': %s", cloudInstanceID, err.Error()))
+		return
+	}
+	if workspace.Details == nil || workspace.Details.Crn == nil {
+		resp.Diagnostics.AddError("Failed to Get Primary Workspace", fmt.Sprintf("Workspace '%s' has no CRN", cloudInstanceID))
+		return
+	}
+
+	vgClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	groups := make([]DisasterRecoveryVolumeGroup, 0, len(config.VolumeGroupIDs))
+	for _, id := range config.VolumeGroupIDs {
+		group, err := getDisasterRecoveryVolumeGroup(ctx, sess, cloudInstanceID, id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Inspect Volume Group", err.Error())
+			return
+		}
+		groups = append(groups, group)
+	}
+
+	targetVolumeClient := instance.NewIBMPIVolumeClient(ctx, targetSess, targetCloudInstanceID)
+	targetVolumes, err := targetVolumeClient.GetAll()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to List Volumes", fmt.Sprintf("Failed to list volumes in workspace '%s': %s", targetCloudInstanceID, err.Error()))
+		return
+	}
+
+	instances := make([]DisasterRecoveryFailoverInstance, 0, len(config.Instances))
+	for _, i := range config.Instances {
+		pvm := DisasterRecoveryFailoverInstance{
+			InstanceID:     i.InstanceID.ValueString(),
+			BootVolumeName: i.BootVolumeName.ValueString(),
+		}
+		for _, name := range i.DataVolumeNames {
+			pvm.DataVolumeNames = append(pvm.DataVolumeNames, name.ValueString())
+		}
+		instances = append(instances, pvm)
+	}
+
+	plan, err := PlanDisasterRecoveryFailover(groups, targetVolumes.Volumes, instances)
+	if err != nil {
+		resp.Diagnostics.AddError("Disaster Recovery Failover Is Not Possible", err.Error())
+		return
+	}
+
+	prefix := ""
+	if dryRun {
+		prefix = "[dry run] "
+	}
+	progress("%sStop replication with access to the auxiliary volumes for %d volume group(s): %s", prefix, len(plan.StopVolumeGroupIDs), strings.Join(plan.StopVolumeGroupIDs, ", "))
+	onboardNames := make([]string, 0, len(plan.Onboard))
+	for _, v := range plan.Onboard {
+		onboardNames = append(onboardNames, v.Name)
+	}
+	progress("%sOnboard %d auxiliary volume(s) into workspace '%s': %s", prefix, len(plan.Onboard), targetCloudInstanceID, strings.Join(onboardNames, ", "))
+	for _, pvm := range plan.Instances {
+		progress("%sBoot instance '%s' from volume '%s' with data volume(s): %s", prefix, pvm.InstanceID, pvm.BootVolumeName, strings.Join(pvm.DataVolumeNames, ", "))
+	}
+	if dryRun {
+		return
+	}
+
+	// Stop replication and give the disaster recovery site access to the auxiliary volumes
+	for _, id := range plan.StopVolumeGroupIDs {
+		progress("Stopping replication for volume group '%s'...", id)
+		_, err := vgClient.VolumeGroupAction(id, &models.VolumeGroupAction{
+			Stop: &models.VolumeGroupActionStop{Access: sl.Bool(true)},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Stop Replication", fmt.Sprintf("Failed to stop replication for volume group '%s': %s", id, err.Error()))
+			return
+		}
+		if _, err := isWaitForIBMPIVolumeGroupFailedOver(ctx, vgClient, id, timeout); err != nil {
+			resp.Diagnostics.AddError("Failed to Stop Replication", fmt.Sprintf("Failed waiting for volume group '%s' to fail over: %s", id, err.Error()))
+			return
+		}
+	}
+
+	// Onboard the auxiliary volumes
+	if len(plan.Onboard) > 0 {
+		progress("Onboarding %d auxiliary volume(s) into workspace '%s'...", len(plan.Onboard), targetCloudInstanceID)
+		onboardingClient := instance.NewIBMPIVolumeOnboardingClient(ctx, targetSess, targetCloudInstanceID)
+		onboarding, err := onboardingClient.CreateVolumeOnboarding(&models.VolumeOnboardingCreate{
+			Description: fmt.Sprintf("Disaster recovery failover from workspace %s", cloudInstanceID),
+			Volumes: []*models.AuxiliaryVolumesForOnboarding{
+				{
+					AuxiliaryVolumes: plan.Onboard,
+					SourceCRN:        workspace.Details.Crn,
+				},
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to Onboard Volumes", fmt.Sprintf("Failed to onboard auxiliary volumes into workspace '%s': %s", targetCloudInstanceID, err.Error()))
+			return
+		}
+		if _, err := isWaitForIBMPIVolumeOnboardingComplete(ctx, onboardingClient, onboarding.ID, timeout); err != nil {
+			resp.Diagnostics.AddError("Failed to Onboard Volumes", fmt.Sprintf("Failed waiting for volume onboarding '%s': %s", onboarding.ID, err.Error()))
+			return
+		}
+		targetVolumes, err = targetVolumeClient.GetAll()
+		if err != nil {
+			resp.Diagnostics.AddError("Failed to List Volumes", fmt.Sprintf("Failed to list volumes in workspace '%s': %s", targetCloudInstanceID, err.Error()))
+			return
+		}
+	}
+
+	// Boot the replacement instances from the onboarded volumes
+	volumeIDs := onboardedVolumeIDs(plan.AuxVolumeNames, targetVolumes.Volumes)
+	instanceClient := instance.NewIBMPIInstanceClient(ctx, targetSess, targetCloudInstanceID)
+	for _, pvm := range plan.Instances {
+		progress("Booting instance '%s' from volume '%s'...", pvm.InstanceID, pvm.BootVolumeName)
+		if err := bootDisasterRecoveryInstance(ctx, instanceClient, targetVolumeClient, pvm, volumeIDs, timeout); err != nil {
+			resp.Diagnostics.AddError("Failed to Boot Replacement Instance", fmt.Sprintf("Failed to boot instance '%s': %s", pvm.InstanceID, err.Error()))
+			return
+		}
+		progress("Instance '%s' is active", pvm.InstanceID)
+	}
+
+	progress("Failover of %d volume group(s) to workspace '%s' completed successfully", len(groups), targetCloudInstanceID)
+}
+
+// getDisasterRecoveryVolumeGroup reads a volume group, its volumes and its
+// remote copy relationships from the primary workspace.
+func getDisasterRecoveryVolumeGroup(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID, id string) (DisasterRecoveryVolumeGroup, error) {
+	group := DisasterRecoveryVolumeGroup{ID: id}
+	vgClient := instance.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	details, err := vgClient.GetDetails(id)
+	if err != nil {
+		return group, fmt.Errorf("failed to get volume group '%s': %s", id, err)
+	}
+	relationships, err := vgClient.GetVolumeGroupRemoteCopyRelationships(id)
+	if err != nil {
+		return group, fmt.Errorf("failed to get remote copy relationships of volume group '%s': %s", id, err)
+	}
+	group.Relationships = relationships.RemoteCopyRelationships
+
+	volumeClient := instance.NewIBMPIVolumeClient(ctx, sess, cloudInstanceID)
+	for _, volumeID := range details.VolumeIDs {
+		volume, err := volumeClient.Get(volumeID)
+		if err != nil {
+			return group, fmt.Errorf("failed to get volume '%s' of volume group '%s': %s", volumeID, id, err)
+		}
+		group.Volumes = append(group.Volumes, volume)
+	}
+	return group, nil
+}
+
+// PlanDisasterRecoveryFailover checks that the volume groups can be failed over
+// and works out which steps are left. Volume groups that were already stopped
+// with access and auxiliary volumes already onboarded into the target
+// workspace are skipped, so an interrupted failover can be invoked again.
+func PlanDisasterRecoveryFailover(groups []DisasterRecoveryVolumeGroup, targetVolumes []*models.VolumeReference, instances []DisasterRecoveryFailoverInstance) (*DisasterRecoveryFailoverPlan, error) {
+	replicated := map[string]string{}
+	plan := &DisasterRecoveryFailoverPlan{AuxVolumeNames: replicated}
+
+	for _, group := range groups {
+		if len(group.Relationships) == 0 {
+			return nil, fmt.Errorf("volume group '%s' has no remote copy relationships", group.ID)
+		}
+		stopped := true
+		for _, r := range group.Relationships {
+			switch r.State {
+			case remoteCopyStateIdling:
+			case remoteCopyStateConsistentSynchronized, remoteCopyStateConsistentCopying, remoteCopyStateConsistentStopped:
+				stopped = false
+			default:
+				return nil, fmt.Errorf("volume group '%s' relationship '%s' is '%s'; its auxiliary volume is not consistent", group.ID, relationshipName(r), r.State)
+			}
+		}
+		if !stopped {
+			plan.StopVolumeGroupIDs = append(plan.StopVolumeGroupIDs, group.ID)
+		}
+
+		for _, v := range group.Volumes {
+			name := ""
+			if v.Name != nil {
+				name = *v.Name
+			}
+			if v.AuxVolumeName == "" {
+				return nil, fmt.Errorf("volume '%s' in volume group '%s' has no auxiliary volume", name, group.ID)
+			}
+			if _, ok := replicated[name]; ok {
+				return nil, fmt.Errorf("volume name '%s' is used more than once in the volume groups", name)
+			}
+			replicated[name] = v.AuxVolumeName
+		}
+	}
+
+	// An onboarded volume is found by its auxiliary volume, its display name may
+	// be taken by an unrelated volume of the target workspace
+	onboarded := onboardedVolumeIDs(replicated, targetVolumes)
+	for name, auxName := range replicated {
+		if _, ok := onboarded[name]; !ok {
+			plan.Onboard = append(plan.Onboard, &models.AuxiliaryVolumeForOnboarding{
+				AuxVolumeName: sl.String(auxName),
+				Name:          name,
+			})
+		}
+	}
+	sort.Slice(plan.Onboard, func(i, j int) bool { return plan.Onboard[i].Name < plan.Onboard[j].Name })
+
+	used := map[string]string{}
+	for _, pvm := range instances {
+		for _, name := range append([]string{pvm.BootVolumeName}, pvm.DataVolumeNames...) {
+			if _, ok := replicated[name]; !ok {
+				return nil, fmt.Errorf("volume '%s' of instance '%s' is not in the volume groups", name, pvm.InstanceID)
+			}
+			if other, ok := used[name]; ok {
+				return nil, fmt.Errorf("volume '%s' is given to both instance '%s' and instance '%s'", name, other, pvm.InstanceID)
+			}
+			used[name] = pvm.InstanceID
+		}
+		plan.Instances = append(plan.Instances, pvm)
+	}
+
+	return plan, nil
+}
+
+// bootDisasterRecoveryInstance shuts off a replacement instance, attaches the
+// onboarded volumes, makes the boot volume bootable and starts the instance.
+func bootDisasterRecoveryInstance(ctx context.Context, instanceClient *instance.IBMPIInstanceClient, volumeClient *instance.IBMPIVolumeClient, pvm DisasterRecoveryFailoverInstance, volumeIDs map[string]string, timeout time.Duration) error {
+	pvmInstance, err := instanceClient.Get(pvm.InstanceID)
+	if err != nil {
+		return err
+	}
+	if pvmInstance.Status == nil || strings.ToLower(*pvmInstance.Status) != State_Shutoff {
+		shutdown := Action_ImmediateShutdown
+		if err := instanceClient.Action(pvm.InstanceID, &models.PVMInstanceAction{Action: &shutdown}); err != nil {
+			return fmt.Errorf("failed to shut off the instance: %s", err)
+		}
+		if _, err := isWaitForPIInstanceActionStatus(ctx, instanceClient, pvm.InstanceID, timeout, State_Shutoff, OK); err != nil {
+			return fmt.Errorf("failed waiting for the instance to shut off: %s", err)
+		}
+	}
+
+	attached := map[string]bool{}
+	for _, id := range pvmInstance.VolumeIDs {
+		attached[id] = true
+	}
+	for _, name := range append([]string{pvm.BootVolumeName}, pvm.DataVolumeNames...) {
+		volumeID, ok := volumeIDs[name]
+		if !ok {
+			return fmt.Errorf("volume '%s' was not found in the workspace", name)
+		}
+		if attached[volumeID] {
+			continue
+		}
+		if err := volumeClient.Attach(pvm.InstanceID, volumeID); err != nil {
+			return fmt.Errorf("failed to attach volume '%s': %s", name, err)
+		}
+		if _, err := isWaitForIBMPIVolumeAttachAvailable(ctx, volumeClient, volumeID, pvm.InstanceID, timeout); err != nil {
+			return fmt.Errorf("failed waiting for volume '%s' to attach: %s", name, err)
+		}
+	}
+	if err := volumeClient.SetBootVolume(pvm.InstanceID, volumeIDs[pvm.BootVolumeName]); err != nil {
+		return fmt.Errorf("failed to set boot volume '%s': %s", pvm.BootVolumeName, err)
+	}
+
+	start := Action_Start
+	if err := instanceClient.Action(pvm.InstanceID, &models.PVMInstanceAction{Action: &start}); err != nil {
+		return fmt.Errorf("failed to start the instance: %s", err)
+	}
+	if _, err := isWaitForPIInstanceActionStatus(ctx, instanceClient, pvm.InstanceID, timeout, State_Active, OK); err != nil {
+		return fmt.Errorf("failed waiting for the instance to start: %s", err)
+	}
+	return nil
+}
+
+func isWaitForIBMPIVolumeGroupFailedOver(ctx context.Context, client *instance.IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) to give access to its auxiliary volumes.", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_Updating},
+		Target:     []string{remoteCopyStateIdling},
+		Refresh:    isIBMPIVolumeGroupFailedOverRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupFailedOverRefreshFunc(client *instance.IBMPIVolumeGroupClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		relationships, err := client.GetVolumeGroupRemoteCopyRelationships(id)
+		if err != nil {
+			return nil, "", err
+		}
+		state := remoteCopyStateIdling
+		for _, r := range relationships.RemoteCopyRelationships {
+			switch r.State {
+			case remoteCopyStateIdling:
+			case remoteCopyStateConsistentSynchronized, remoteCopyStateConsistentCopying, remoteCopyStateConsistentStopped:
+				state = State_Updating
+			default:
+				// An error or inconsistent relationship does not become idling
+				return relationships, r.State, fmt.Errorf("relationship '%s' is '%s'", relationshipName(r), r.State)
+			}
+		}
+		return relationships, state, nil
+	}
+}
+
+func isWaitForIBMPIVolumeOnboardingComplete(ctx context.Context, client *instance.IBMPIVolumeOnboardingClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Onboarding (%s) to complete.", id)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{State_InProgress},
+		Target:     []string{State_Completed},
+		Refresh:    isIBMPIVolumeOnboardingRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeOnboardingRefreshFunc(client *instance.IBMPIVolumeOnboardingClient, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		onboarding, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+		switch onboarding.Status {
+		case "", State_Queued, State_Pending, State_PENDING, State_InProgress, State_inProgress, State_Running:
+			return onboarding, State_InProgress, nil
+		}
+		failures := []string{}
+		if onboarding.Results != nil {
+			for _, f := range onboarding.Results.VolumeOnboardingFailures {
+				failures = append(failures, fmt.Sprintf("%s: %s", strings.Join(f.Volumes, ", "), f.FailureMessage))
+			}
+		}
+		if len(failures) > 0 {
+			return onboarding, onboarding.Status, fmt.Errorf("volume onboarding %s: %s", onboarding.Status, strings.Join(failures, "; "))
+		}
+		// Only a successful onboarding completes, any other final status is a
+		// failure even when no failure details are returned
+		switch strings.ToLower(onboarding.Status) {
+		case State_Completed, State_Success:
+			return onboarding, State_Completed, nil
+		}
+		return onboarding, onboarding.Status, fmt.Errorf("volume onboarding ended with status %q", onboarding.Status)
+	}
+}
+
+func relationshipName(r *models.RemoteCopyRelationship) string {
+	if r.Name != nil {
+		return *r.Name
+	}
+	return r.MasterVolumeName
+}
+
+// onboardedVolumeIDs returns the IDs of the onboarded volumes of the target
+// workspace by the display name of their primary volume.
+func onboardedVolumeIDs(auxVolumeNames map[string]string, targetVolumes []*models.VolumeReference) map[string]string {
+	byAuxVolumeName := map[string]string{}
+	for _, v := range targetVolumes {
+		if v.AuxVolumeName != "" && v.VolumeID != nil {
+			byAuxVolumeName[v.AuxVolumeName] = *v.VolumeID
+		}
+	}
+	volumeIDs := map[string]string{}
+	for name, auxName := range auxVolumeNames {
+		if id, ok := byAuxVolumeName[auxName]; ok {
+			volumeIDs[name] = id
+		}
+	}
+	return volumeIDs
+}
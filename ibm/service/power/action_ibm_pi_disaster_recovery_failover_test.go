@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/softlayer/softlayer-go/sl"
+)
+
+func TestAccIBMPIDisasterRecoveryFailoverDryRun(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"null": {
+				Source:            "hashicorp/null",
+				VersionConstraint: "~> 3.0",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIDisasterRecoveryFailoverDryRunConfig(),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIDisasterRecoveryFailoverDryRunConfig() string {
+	return fmt.Sprintf(`
+	action "ibm_pi_disaster_recovery_failover" "failover" {
+		config {
+			pi_cloud_instance_id        = "%[1]s"
+			pi_target_cloud_instance_id = "%[1]s"
+			pi_volume_group_ids         = ["%[2]s"]
+			pi_dry_run                  = true
+		}
+	}
+
+	resource "null_resource" "trigger_failover" {
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_pi_disaster_recovery_failover.failover]
+			}
+		}
+	}
+	`, acc.Pi_cloud_instance_id, acc.Pi_volume_group_id)
+}
+
+func testDisasterRecoveryVolume(name, auxName string) *models.Volume {
+	return &models.Volume{Name: sl.String(name), AuxVolumeName: auxName}
+}
+
+func testDisasterRecoveryRelationship(name, state string) *models.RemoteCopyRelationship {
+	return &models.RemoteCopyRelationship{Name: sl.String(name), State: state}
+}
+
+func TestPlanDisasterRecoveryFailover(t *testing.T) {
+	groups := []power.DisasterRecoveryVolumeGroup{
+		{
+			ID:            "vg-1",
+			Volumes:       []*models.Volume{testDisasterRecoveryVolume("boot", "aux_boot"), testDisasterRecoveryVolume("data", "aux_data")},
+			Relationships: []*models.RemoteCopyRelationship{testDisasterRecoveryRelationship("rc-1", "consistent_synchronized"), testDisasterRecoveryRelationship("rc-2", "consistent_synchronized")},
+		},
+		{
+			ID:            "vg-2",
+			Volumes:       []*models.Volume{testDisasterRecoveryVolume("logs", "aux_logs")},
+			Relationships: []*models.RemoteCopyRelationship{testDisasterRecoveryRelationship("rc-3", "idling")},
+		},
+	}
+	instances := []power.DisasterRecoveryFailoverInstance{
+		{InstanceID: "pvm-1", BootVolumeName: "boot", DataVolumeNames: []string{"data", "logs"}},
+	}
+
+	// "data" is the display name of an unrelated volume of the target workspace
+	targetVolumes := []*models.VolumeReference{
+		{Name: sl.String("logs"), VolumeID: sl.String("vol-logs"), AuxVolumeName: "aux_logs"},
+		{Name: sl.String("data"), VolumeID: sl.String("vol-other")},
+	}
+	plan, err := power.PlanDisasterRecoveryFailover(groups, targetVolumes, instances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.StopVolumeGroupIDs) != 1 || plan.StopVolumeGroupIDs[0] != "vg-1" {
+		t.Errorf("expected only vg-1 to be stopped, got %v", plan.StopVolumeGroupIDs)
+	}
+	if len(plan.Onboard) != 2 || plan.Onboard[0].Name != "boot" || *plan.Onboard[0].AuxVolumeName != "aux_boot" || plan.Onboard[1].Name != "data" {
+		t.Errorf("expected boot and data to be onboarded, got %v", plan.Onboard)
+	}
+	if len(plan.Instances) != 1 {
+		t.Errorf("expected one instance, got %v", plan.Instances)
+	}
+
+	failures := map[string]struct {
+		groups    []power.DisasterRecoveryVolumeGroup
+		instances []power.DisasterRecoveryFailoverInstance
+	}{
+		"not consistent": {
+			groups: []power.DisasterRecoveryVolumeGroup{{
+				ID:            "vg-1",
+				Volumes:       []*models.Volume{testDisasterRecoveryVolume("boot", "aux_boot")},
+				Relationships: []*models.RemoteCopyRelationship{testDisasterRecoveryRelationship("rc-1", "inconsistent_copying")},
+			}},
+		},
+		"no auxiliary volume": {
+			groups: []power.DisasterRecoveryVolumeGroup{{
+				ID:            "vg-1",
+				Volumes:       []*models.Volume{testDisasterRecoveryVolume("boot", "")},
+				Relationships: []*models.RemoteCopyRelationship{testDisasterRecoveryRelationship("rc-1", "consistent_synchronized")},
+			}},
+		},
+		"is not in the volume groups": {
+			groups:    groups,
+			instances: []power.DisasterRecoveryFailoverInstance{{InstanceID: "pvm-1", BootVolumeName: "other"}},
+		},
+		"is given to both": {
+			groups: groups,
+			instances: []power.DisasterRecoveryFailoverInstance{
+				{InstanceID: "pvm-1", BootVolumeName: "boot"},
+				{InstanceID: "pvm-2", BootVolumeName: "data", DataVolumeNames: []string{"boot"}},
+			},
+		},
+	}
+	for want, tc := range failures {
+		_, err := power.PlanDisasterRecoveryFailover(tc.groups, nil, tc.instances)
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("expected an error containing %q, got %v", want, err)
+		}
+	}
+}
@@ -9,7 +9,6 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +42,8 @@ func DataSourceIBMPINetwork() *schema.Resource {
 				Type:          schema.TypeString,
 			},
 
+			Arg_Zone: dataSourceIBMPIZoneSchema(),
+
 			// Attributes
 			Attr_Advertise: {
 				Computed:    true,
@@ -147,7 +148,7 @@ func DataSourceIBMPINetwork() *schema.Resource {
 }
 
 func dataSourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "(Data) ibm_pi_network", "read")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -202,6 +203,7 @@ func dataSourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, met
 	if networkdata.Name != nil {
 		d.Set(Attr_Name, networkdata.Name)
 	}
+	d.Set(Arg_Zone, sess.Options.Zone)
 	networkAddressTranslation := []map[string]any{}
 	if networkdata.NetworkAddressTranslation != nil {
 		natMap := networkAddressTranslationToMap(networkdata.NetworkAddressTranslation)
@@ -9,7 +9,6 @@ import (
 	"log"
 
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +42,8 @@ func DataSourceIBMPIVolume() *schema.Resource {
 				Type:          schema.TypeString,
 			},
 
+			Arg_Zone: dataSourceIBMPIZoneSchema(),
+
 			// Attributes
 			Attr_Auxiliary: {
 				Computed:    true,
@@ -187,7 +188,7 @@ func DataSourceIBMPIVolume() *schema.Resource {
 }
 
 func dataSourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "(Data) ibm_pi_volume", "read")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -234,6 +235,7 @@ func dataSourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(Attr_MasterVolumeName, volumedata.MasterVolumeName)
 	d.Set(Attr_MirroringState, volumedata.MirroringState)
 	d.Set(Attr_Name, volumedata.Name)
+	d.Set(Arg_Zone, sess.Options.Zone)
 	d.Set(Attr_OutOfBandDeleted, volumedata.OutOfBandDeleted)
 	d.Set(Attr_PrimaryRole, volumedata.PrimaryRole)
 	d.Set(Attr_ReplicationEnabled, volumedata.ReplicationEnabled)
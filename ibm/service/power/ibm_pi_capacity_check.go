@@ -0,0 +1,393 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// piCapacityCacheTTL is how long the capacity of a workspace is reused
+	// across the resources of a single plan.
+	piCapacityCacheTTL = 2 * time.Minute
+)
+
+// capacityCheckSchema is the pi_capacity_check argument of the resources that
+// validate their request against the capacity of the workspace at plan time.
+func capacityCheckSchema() *schema.Schema {
+	return &schema.Schema{
+		Description:  "How a request that does not fit in the workspace is reported at plan time. Supported values are `error`, `warn` and `off`. The capacity is not checked when unset.",
+		Optional:     true,
+		Type:         schema.TypeString,
+		ValidateFunc: validate.ValidateAllowedStringValues([]string{CapacityCheckError, CapacityCheckWarn, CapacityCheckOff}),
+	}
+}
+
+// piCapacityCache caches capacity responses per workspace, so that a plan with
+// many instances or volumes in the same workspace calls each API once.
+type piCapacityCache struct {
+	lock    sync.Mutex
+	entries map[string]*piCapacityCacheEntry
+}
+
+type piCapacityCacheEntry struct {
+	lock    sync.Mutex
+	fetched time.Time
+	value   interface{}
+}
+
+var piCapacity = &piCapacityCache{entries: map[string]*piCapacityCacheEntry{}}
+
+// get returns the cached value for key, calling fetch when there is none or it
+// has expired. Failed fetches are not cached, and expired entries are evicted.
+func (c *piCapacityCache) get(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	c.lock.Lock()
+	for k, e := range c.entries {
+		if k != key && e.expired() {
+			delete(c.entries, k)
+		}
+	}
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &piCapacityCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.lock.Unlock()
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+	if entry.value != nil && time.Since(entry.fetched) < piCapacityCacheTTL {
+		return entry.value, nil
+	}
+	value, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	entry.value, entry.fetched = value, time.Now()
+	return value, nil
+}
+
+// expired reports whether the entry holds no value that can be reused. An
+// entry that is being fetched holds its lock and is not expired.
+func (e *piCapacityCacheEntry) expired() bool {
+	if !e.lock.TryLock() {
+		return false
+	}
+	defer e.lock.Unlock()
+	return time.Since(e.fetched) >= piCapacityCacheTTL
+}
+
+func piCapacityCacheKey(sess *ibmpisession.IBMPISession, cloudInstanceID, kind string) string {
+	return strings.Join([]string{sess.Options.Zone, cloudInstanceID, kind}, "/")
+}
+
+func getPISystemPools(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) (models.SystemPools, error) {
+	value, err := piCapacity.get(piCapacityCacheKey(sess, cloudInstanceID, "system-pools"), func() (interface{}, error) {
+		return instance.NewIBMPISystemPoolClient(ctx, sess, cloudInstanceID).GetSystemPools()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(models.SystemPools), nil
+}
+
+func getPIStorageTypesCapacity(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) (*models.StorageTypesCapacity, error) {
+	value, err := piCapacity.get(piCapacityCacheKey(sess, cloudInstanceID, "storage-types"), func() (interface{}, error) {
+		return instance.NewIBMPIStorageCapacityClient(ctx, sess, cloudInstanceID).GetAllStorageTypesCapacity()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*models.StorageTypesCapacity), nil
+}
+
+func getPIHosts(ctx context.Context, sess *ibmpisession.IBMPISession, cloudInstanceID string) (models.HostList, error) {
+	value, err := piCapacity.get(piCapacityCacheKey(sess, cloudInstanceID, "hosts"), func() (interface{}, error) {
+		return instance.NewIBMPIHostGroupsClient(ctx, sess, cloudInstanceID).GetHosts()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(models.HostList), nil
+}
+
+// CheckPISystemPoolsCapacity returns an error when no system of sysType, or of
+// any type when sysType is empty, has processors cores and memory GB
+// available. The error reports the largest available option.
+func CheckPISystemPoolsCapacity(pools models.SystemPools, sysType string, processors, memory float64) error {
+	names := make([]string, 0, len(pools))
+	for name := range pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var largest []string
+	for _, name := range names {
+		if sysType != "" && name != sysType {
+			continue
+		}
+		pool := pools[name]
+		for _, system := range pool.Systems {
+			if system != nil && system.Cores != nil && system.Memory != nil &&
+				*system.Cores >= processors && float64(*system.Memory) >= memory {
+				return nil
+			}
+		}
+		if max := pool.MaxAvailable; max != nil && max.Cores != nil && max.Memory != nil {
+			largest = append(largest, fmt.Sprintf("%g cores and %d GB on %s", *max.Cores, *max.Memory, name))
+		}
+	}
+
+	if sysType != "" && len(largest) == 0 {
+		if _, ok := pools[sysType]; !ok {
+			return fmt.Errorf("system type %s is not available in the workspace; available system types are %s", sysType, strings.Join(names, ", "))
+		}
+	}
+	if len(largest) == 0 {
+		return fmt.Errorf("%g cores and %g GB of memory do not fit in the workspace; no system has capacity available", processors, memory)
+	}
+	return fmt.Errorf("%g cores and %g GB of memory do not fit in the workspace; the largest available is %s", processors, memory, strings.Join(largest, ", "))
+}
+
+// CheckPIHostsCapacity returns an error when none of hosts has processors
+// cores and memory GB available.
+func CheckPIHostsCapacity(hosts []*models.Host, processors, memory float64) error {
+	var largest []string
+	for _, host := range hosts {
+		if host == nil || host.Capacity == nil || host.Capacity.Cores == nil || host.Capacity.Memory == nil ||
+			host.Capacity.Cores.Available == nil || host.Capacity.Memory.Available == nil {
+			continue
+		}
+		cores, mem := *host.Capacity.Cores.Available, *host.Capacity.Memory.Available
+		if cores >= processors && mem >= memory {
+			return nil
+		}
+		largest = append(largest, fmt.Sprintf("%g cores and %g GB on %s", cores, mem, host.DisplayName))
+	}
+	if len(largest) == 0 {
+		return fmt.Errorf("%g cores and %g GB of memory do not fit on the deployment target; no host has capacity available", processors, memory)
+	}
+	return fmt.Errorf("%g cores and %g GB of memory do not fit on the deployment target; the largest available is %s", processors, memory, strings.Join(largest, ", "))
+}
+
+// CheckPIStorageCapacity returns an error when storageType or storagePool is
+// not available in the workspace, or when a single allocation of size GB does
+// not fit in them. Empty values match any storage type or pool.
+func CheckPIStorageCapacity(capacity *models.StorageTypesCapacity, storageType, storagePool string, size float64) error {
+	if capacity == nil {
+		return nil
+	}
+
+	var types []string
+	var largest *models.StoragePoolCapacity
+	for _, t := range capacity.StorageTypesCapacity {
+		if t == nil {
+			continue
+		}
+		types = append(types, t.StorageType)
+		if storageType != "" && t.StorageType != storageType {
+			continue
+		}
+		for _, pool := range t.StoragePoolsCapacity {
+			if pool == nil || (storagePool != "" && pool.PoolName != storagePool) {
+				continue
+			}
+			if largest == nil || maxAllocationSize(pool) > maxAllocationSize(largest) {
+				largest = pool
+			}
+		}
+	}
+
+	if largest == nil {
+		switch {
+		case storagePool != "" && storageType != "":
+			return fmt.Errorf("storage pool %s of storage type %s is not available in the workspace", storagePool, storageType)
+		case storagePool != "":
+			return fmt.Errorf("storage pool %s is not available in the workspace", storagePool)
+		case storageType != "":
+			return fmt.Errorf("storage type %s is not available in the workspace; available storage types are %s", storageType, strings.Join(types, ", "))
+		}
+		return nil
+	}
+	if float64(maxAllocationSize(largest)) < size {
+		return fmt.Errorf("%g GB does not fit in the workspace; the largest available is %d GB of %s in storage pool %s", size, maxAllocationSize(largest), largest.StorageType, largest.PoolName)
+	}
+	return nil
+}
+
+func maxAllocationSize(pool *models.StoragePoolCapacity) int64 {
+	if pool.MaxAllocationSize == nil {
+		return 0
+	}
+	return *pool.MaxAllocationSize
+}
+
+// reportCapacityCheck reports err according to the pi_capacity_check argument
+// of diff. Warnings cannot be shown in a plan, so they are logged.
+func reportCapacityCheck(diff *schema.ResourceDiff, err error) error {
+	if err == nil {
+		return nil
+	}
+	if diff.Get(Arg_CapacityCheck).(string) == CapacityCheckWarn {
+		log.Printf("[WARN] capacity check: %s", err)
+		return nil
+	}
+	return err
+}
+
+// capacityCheckEnabled reports whether the capacity of the workspace should
+// be checked. The check is opt-in and requires the workspace to be known at
+// plan time.
+func capacityCheckEnabled(diff *schema.ResourceDiff) bool {
+	mode := diff.Get(Arg_CapacityCheck).(string)
+	return (mode == CapacityCheckError || mode == CapacityCheckWarn) &&
+		diff.NewValueKnown(Arg_CloudInstanceID) && diff.Get(Arg_CloudInstanceID).(string) != ""
+}
+
+// capacityString returns the value of key that a diff requests the capacity
+// for: the configured value on create, where an unset value matches any
+// option, and the current value on update. It returns false when the value is
+// unknown.
+func capacityString(diff *schema.ResourceDiff, key string) (string, bool) {
+	if diff.Id() != "" {
+		return diff.Get(key).(string), true
+	}
+	v := diff.GetRawConfig().GetAttr(key)
+	if !v.IsKnown() {
+		return "", false
+	}
+	if v.IsNull() {
+		return "", true
+	}
+	return v.AsString(), true
+}
+
+// capacityRequest returns the amount of key a diff requests: the whole value
+// on create and the increase on update. It returns false when the value is
+// unknown or nothing more is requested.
+func capacityRequest(diff *schema.ResourceDiff, key string) (float64, bool) {
+	if !diff.NewValueKnown(key) {
+		return 0, false
+	}
+	old, new := diff.GetChange(key)
+	request := new.(float64)
+	if diff.Id() != "" {
+		request -= old.(float64)
+	}
+	return request, request > 0
+}
+
+// resourceIBMPIInstanceCapacityCustomizeDiff fails, or with warn logs a
+// warning for, the plan of an instance whose processors, memory or storage do
+// not fit in the workspace or on its deployment target.
+func resourceIBMPIInstanceCapacityCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !capacityCheckEnabled(diff) {
+		return nil
+	}
+	processors, processorsRequested := capacityRequest(diff, Arg_Processors)
+	memory, memoryRequested := capacityRequest(diff, Arg_Memory)
+	storageType, storageTypeKnown := capacityString(diff, Arg_StorageType)
+	storagePool, storagePoolKnown := capacityString(diff, Arg_StoragePool)
+	checkStorage := diff.Id() == "" && storageTypeKnown && storagePoolKnown
+	if !processorsRequested && !memoryRequested && !checkStorage {
+		return nil
+	}
+
+	sess, err := piSessionForZone(diff, meta)
+	if err != nil {
+		return nil
+	}
+	cloudInstanceID := diff.Get(Arg_CloudInstanceID).(string)
+
+	if processorsRequested || memoryRequested {
+		if target, ok := diff.GetOk(Arg_DeploymentTarget); ok && len(target.(*schema.Set).List()) > 0 {
+			deploymentTarget := target.(*schema.Set).List()[0].(map[string]interface{})
+			hosts, err := getPIHosts(ctx, sess, cloudInstanceID)
+			if err != nil {
+				log.Printf("[WARN] skipping the capacity check of the deployment target: %s", err)
+			} else if err := reportCapacityCheck(diff, CheckPIHostsCapacity(deploymentTargetHosts(hosts, deploymentTarget), processors, memory)); err != nil {
+				return err
+			}
+		} else if sysType, ok := capacityString(diff, Arg_SysType); ok {
+			pools, err := getPISystemPools(ctx, sess, cloudInstanceID)
+			if err != nil {
+				log.Printf("[WARN] skipping the capacity check of the system pools: %s", err)
+			} else if err := reportCapacityCheck(diff, CheckPISystemPoolsCapacity(pools, sysType, processors, memory)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if checkStorage {
+		capacity, err := getPIStorageTypesCapacity(ctx, sess, cloudInstanceID)
+		if err != nil {
+			log.Printf("[WARN] skipping the capacity check of the storage types: %s", err)
+		} else if err := reportCapacityCheck(diff, CheckPIStorageCapacity(capacity, storageType, storagePool, 0)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deploymentTargetHosts returns the hosts an instance with deploymentTarget
+// can be placed on.
+func deploymentTargetHosts(hosts models.HostList, deploymentTarget map[string]interface{}) []*models.Host {
+	id := deploymentTarget[Attr_ID].(string)
+	var matched []*models.Host
+	for _, host := range hosts {
+		if host == nil {
+			continue
+		}
+		switch deploymentTarget[Attr_Type].(string) {
+		case Host:
+			if host.ID == id {
+				matched = append(matched, host)
+			}
+		case HostGroup:
+			if host.HostGroup != nil && path.Base(host.HostGroup.Href) == id {
+				matched = append(matched, host)
+			}
+		}
+	}
+	return matched
+}
+
+// resourceIBMPIVolumeCapacityCustomizeDiff fails, or with warn logs a warning
+// for, the plan of a volume whose size does not fit in its storage type or
+// pool.
+func resourceIBMPIVolumeCapacityCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !capacityCheckEnabled(diff) {
+		return nil
+	}
+	size, ok := capacityRequest(diff, Arg_VolumeSize)
+	volumeType, volumeTypeKnown := capacityString(diff, Arg_VolumeType)
+	volumePool, volumePoolKnown := capacityString(diff, Arg_VolumePool)
+	if !ok || !volumeTypeKnown || !volumePoolKnown {
+		return nil
+	}
+
+	sess, err := piSessionForZone(diff, meta)
+	if err != nil {
+		return nil
+	}
+	capacity, err := getPIStorageTypesCapacity(ctx, sess, diff.Get(Arg_CloudInstanceID).(string))
+	if err != nil {
+		log.Printf("[WARN] skipping the capacity check of the storage types: %s", err)
+		return nil
+	}
+	return reportCapacityCheck(diff, CheckPIStorageCapacity(capacity, volumeType, volumePool, size))
+}
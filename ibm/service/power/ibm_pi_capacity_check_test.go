@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/power"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func testCapacitySystem(cores float64, memory int64) *models.System {
+	return &models.System{Cores: &cores, Memory: &memory}
+}
+
+func TestCheckPISystemPoolsCapacity(t *testing.T) {
+	pools := models.SystemPools{
+		"s922": {
+			MaxAvailable: testCapacitySystem(4, 128),
+			Systems:      []*models.System{testCapacitySystem(2, 256), testCapacitySystem(4, 128)},
+		},
+		"e980": {
+			MaxAvailable: testCapacitySystem(16, 512),
+			Systems:      []*models.System{testCapacitySystem(16, 512)},
+		},
+	}
+
+	for _, tc := range []struct {
+		sysType            string
+		processors, memory float64
+		want               string
+	}{
+		{"s922", 4, 128, ""},
+		{"s922", 2, 200, ""},
+		{"", 8, 64, ""},
+		{"s922", 4, 200, "the largest available is 4 cores and 128 GB on s922"},
+		{"", 32, 64, "the largest available is 16 cores and 512 GB on e980, 4 cores and 128 GB on s922"},
+		{"s1022", 1, 2, "system type s1022 is not available in the workspace; available system types are e980, s922"},
+	} {
+		err := power.CheckPISystemPoolsCapacity(pools, tc.sysType, tc.processors, tc.memory)
+		if tc.want == "" && err != nil {
+			t.Errorf("%s %g/%g: unexpected error %v", tc.sysType, tc.processors, tc.memory, err)
+		}
+		if tc.want != "" && (err == nil || !strings.Contains(err.Error(), tc.want)) {
+			t.Errorf("%s %g/%g: expected an error containing %q, got %v", tc.sysType, tc.processors, tc.memory, tc.want, err)
+		}
+	}
+}
+
+func TestCheckPIStorageCapacity(t *testing.T) {
+	capacity := &models.StorageTypesCapacity{
+		StorageTypesCapacity: []*models.StorageTypeCapacity{
+			{
+				StorageType: "tier1",
+				StoragePoolsCapacity: []*models.StoragePoolCapacity{
+					{PoolName: "Tier1-Flash-1", StorageType: "tier1", MaxAllocationSize: core.Int64Ptr(500)},
+					{PoolName: "Tier1-Flash-2", StorageType: "tier1", MaxAllocationSize: core.Int64Ptr(2000)},
+				},
+			},
+			{
+				StorageType: "tier3",
+				StoragePoolsCapacity: []*models.StoragePoolCapacity{
+					{PoolName: "Tier3-Flash-1", StorageType: "tier3", MaxAllocationSize: core.Int64Ptr(100)},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		storageType, storagePool string
+		size                     float64
+		want                     string
+	}{
+		{"", "", 2000, ""},
+		{"tier1", "", 1000, ""},
+		{"tier1", "Tier1-Flash-1", 500, ""},
+		{"tier1", "Tier1-Flash-1", 1000, "the largest available is 500 GB of tier1 in storage pool Tier1-Flash-1"},
+		{"tier3", "", 200, "the largest available is 100 GB of tier3 in storage pool Tier3-Flash-1"},
+		{"tier0", "", 10, "storage type tier0 is not available in the workspace; available storage types are tier1, tier3"},
+		{"", "Tier5-Flash-1", 10, "storage pool Tier5-Flash-1 is not available in the workspace"},
+	} {
+		err := power.CheckPIStorageCapacity(capacity, tc.storageType, tc.storagePool, tc.size)
+		if tc.want == "" && err != nil {
+			t.Errorf("%s/%s %g: unexpected error %v", tc.storageType, tc.storagePool, tc.size, err)
+		}
+		if tc.want != "" && (err == nil || !strings.Contains(err.Error(), tc.want)) {
+			t.Errorf("%s/%s %g: expected an error containing %q, got %v", tc.storageType, tc.storagePool, tc.size, tc.want, err)
+		}
+	}
+}
@@ -15,7 +15,9 @@ const (
 	Arg_ARPBroadcast                         = "pi_arp_broadcast"
 	Arg_AuxiliaryVolumeName                  = "pi_auxiliary_volume_name"
 	Arg_AuxiliaryVolumes                     = "pi_auxiliary_volumes"
+	Arg_BootVolumeName                       = "pi_boot_volume_name"
 	Arg_BootVolumeReplicationEnabled         = "pi_boot_volume_replication_enabled"
+	Arg_CapacityCheck                        = "pi_capacity_check"
 	Arg_CaptureCloudStorageAccessKey         = "pi_capture_cloud_storage_access_key"
 	Arg_CaptureCloudStorageRegion            = "pi_capture_cloud_storage_region"
 	Arg_CaptureCloudStorageSecretKey         = "pi_capture_cloud_storage_secret_key"
@@ -24,6 +26,7 @@ const (
 	Arg_CaptureStorageImagePath              = "pi_capture_storage_image_path"
 	Arg_CaptureVolumeIDs                     = "pi_capture_volume_ids"
 	Arg_Cidr                                 = "pi_cidr"
+	Arg_CleanupStaging                       = "pi_cleanup_staging"
 	Arg_CloudConnectionClassicEnabled        = "pi_cloud_connection_classic_enabled"
 	Arg_CloudConnectionGlobalRouting         = "pi_cloud_connection_global_routing"
 	Arg_CloudConnectionGreCidr               = "pi_cloud_connection_gre_cidr"
@@ -42,6 +45,7 @@ const (
 	Arg_CustomerCIDR                         = "pi_customer_cidr"
 	Arg_Datacenter                           = "pi_datacenter"
 	Arg_DatacenterZone                       = "pi_datacenter_zone"
+	Arg_DataVolumeNames                      = "pi_data_volume_names"
 	Arg_DefaultExportRouteFilter             = "pi_default_export_route_filter"
 	Arg_DefaultImportRouteFilter             = "pi_default_import_route_filter"
 	Arg_DefaultTrustedProfile                = "pi_default_trusted_profile"
@@ -59,6 +63,7 @@ const (
 	Arg_DisplayName                          = "pi_display_name"
 	Arg_DNS                                  = "pi_dns"
 	Arg_DnsServer                            = "pi_dns_server"
+	Arg_DryRun                               = "pi_dry_run"
 	Arg_Enabled                              = "pi_enabled"
 	Arg_EnableDHCP                           = "pi_enable_dhcp"
 	Arg_EndingIPAddress                      = "pi_ending_ip_address"
@@ -89,6 +94,7 @@ const (
 	Arg_Index                                = "pi_index"
 	Arg_InstanceID                           = "pi_instance_id"
 	Arg_InstanceName                         = "pi_instance_name"
+	Arg_Instances                            = "pi_instances"
 	Arg_IPAddress                            = "pi_ip_address"
 	Arg_IPAddressRange                       = "pi_ipaddress_range"
 	Arg_Key                                  = "pi_ssh_key"
@@ -175,7 +181,11 @@ const (
 	Arg_StorageType                          = "pi_storage_type"
 	Arg_SysType                              = "pi_sys_type"
 	Arg_Target                               = "pi_target"
+	Arg_TargetCloudInstanceID                = "pi_target_cloud_instance_id"
 	Arg_TargetStorageTier                    = "pi_target_storage_tier"
+	Arg_TargetWorkspaceCRNs                  = "pi_target_workspace_crns"
+	Arg_TargetZone                           = "pi_target_zone"
+	Arg_Timeout                              = "pi_timeout"
 	Arg_Type                                 = "pi_type"
 	Arg_UserData                             = "pi_user_data"
 	Arg_UserTags                             = "pi_user_tags"
@@ -189,6 +199,7 @@ const (
 	Arg_VolumeCloneTaskID                    = "pi_volume_clone_task_id"
 	Arg_VolumeGroupAction                    = "pi_volume_group_action"
 	Arg_VolumeGroupID                        = "pi_volume_group_id"
+	Arg_VolumeGroupIDs                       = "pi_volume_group_ids"
 	Arg_VolumeGroupName                      = "pi_volume_group_name"
 	Arg_VolumeID                             = "pi_volume_id"
 	Arg_VolumeIDs                            = "pi_volume_ids"
@@ -202,6 +213,7 @@ const (
 	Arg_VPMEMVolumeID                        = "pi_vpmem_volume_id"
 	Arg_VPMEMVolumes                         = "pi_vpmem_volumes"
 	Arg_VTL                                  = "vtl"
+	Arg_Zone                                 = "pi_zone"
 
 	// Attributes
 	Attr_Access                              = "access"
@@ -471,6 +483,7 @@ const (
 	Attr_RemoteCopyRelationshipNames         = "remote_copy_relationship_names"
 	Attr_RemoteCopyRelationships             = "remote_copy_relationships"
 	Attr_RemotePool                          = "remote_pool"
+	Attr_Replicas                            = "replicas"
 	Attr_ReplicationEnabled                  = "replication_enabled"
 	Attr_ReplicationPoolMap                  = "replication_pool_map"
 	Attr_ReplicationSites                    = "replication_sites"
@@ -514,6 +527,7 @@ const (
 	Attr_SPPPlacementGroups                  = "spp_placement_groups"
 	Attr_SSHKey                              = "ssh_key"
 	Attr_SSHKeyID                            = "ssh_key_id"
+	Attr_StagingObject                       = "staging_object"
 	Attr_Start                               = "start"
 	Attr_StartTime                           = "start_time"
 	Attr_State                               = "state"
@@ -590,6 +604,7 @@ const (
 	Attr_WorkloadType                        = "workload_type"
 	Attr_Workspace                           = "workspace"
 	Attr_WorkspaceCapabilities               = "pi_workspace_capabilities"
+	Attr_WorkspaceCRN                        = "workspace_crn"
 	Attr_WorkspaceDetails                    = "pi_workspace_details"
 	Attr_WorkspaceID                         = "pi_workspace_id"
 	Attr_WorkspaceLocation                   = "pi_workspace_location"
@@ -618,6 +633,9 @@ const (
 	Bidirectional_Static_Route = "bidirectional-static-route"
 	Both                       = "both"
 	BYOL                       = "byol"
+	CapacityCheckError         = "error"
+	CapacityCheckOff           = "off"
+	CapacityCheckWarn          = "warn"
 	Capped                     = "capped"
 	CloudStorage               = "cloud-storage"
 	Create                     = "create"
@@ -727,6 +745,8 @@ const (
 	State_Shutoff            = "shutoff"
 	State_SHUTOFF            = "SHUTOFF"
 	State_Stopping           = "stopping"
+	State_Success            = "success"
+	State_Unknown            = "unknown"
 	State_Up                 = "up"
 	State_Updating           = "updating"
 	State_VerifyResize       = "verify_resize"
@@ -0,0 +1,682 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
+	"github.com/IBM/ibm-cos-sdk-go/aws/session"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	// imageReplicaStagingPrefix is the folder, below the configured bucket
+	// folder, that the source image is exported to before it is imported into
+	// each target.
+	imageReplicaStagingPrefix = "pi-image-replica"
+
+	// imageReplicaSourceTagPrefix prefixes the user tag that records the ID of
+	// the source image on each replica, so that replicas can be found again
+	// in their target workspaces.
+	imageReplicaSourceTagPrefix = "pi-image-replica-source:"
+)
+
+func ResourceIBMPIImageReplica() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIImageReplicaCreate,
+		ReadContext:   resourceIBMPIImageReplicaRead,
+		UpdateContext: resourceIBMPIImageReplicaUpdate,
+		DeleteContext: resourceIBMPIImageReplicaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIZonalImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(120 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceIBMPIImageReplicaCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			// Arguments
+			Arg_CleanupStaging: {
+				Default:     true,
+				Description: "Indicates whether the exported image is deleted from the staging bucket once it is imported into the target workspaces.",
+				Optional:    true,
+				Type:        schema.TypeBool,
+			},
+			Arg_CloudInstanceID: {
+				Description:  "The GUID of the service instance that owns the source image.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ImageAccessKey: {
+				Description:  "The HMAC access key of the staging Cloud Object Storage bucket.",
+				Required:     true,
+				Sensitive:    true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ImageBucketName: {
+				Description:  "The staging Cloud Object Storage bucket; bucket-name[/optional/folder].",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ImageBucketRegion: {
+				Description:  "The region of the staging Cloud Object Storage bucket.",
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ImageID: {
+				Description:  "The ID of the source image.",
+				ForceNew:     true,
+				Required:     true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ImageName: {
+				Computed:    true,
+				Description: "The name of the image in the target workspaces. Defaults to the name of the source image.",
+				ForceNew:    true,
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_ImageSecretKey: {
+				Description:  "The HMAC secret key of the staging Cloud Object Storage bucket.",
+				Required:     true,
+				Sensitive:    true,
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_ImageStorageType: {
+				Description: "The storage type of the image in the target workspaces. If not specified, the default storage type of each workspace is used.",
+				Optional:    true,
+				Type:        schema.TypeString,
+			},
+			Arg_TargetWorkspaceCRNs: {
+				Description: "The CRNs of the workspaces to replicate the image to.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				MinItems:    1,
+				Required:    true,
+				Set:         schema.HashString,
+				Type:        schema.TypeSet,
+			},
+			Arg_Zone: resourceIBMPIZoneSchema(),
+
+			// Attributes
+			Attr_Replicas: {
+				Computed:    true,
+				Description: "The replicas of the image, one for each target workspace.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_CloudInstanceID: {
+							Computed:    true,
+							Description: "The GUID of the target workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_ImageID: {
+							Computed:    true,
+							Description: "The ID of the image in the target workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_Message: {
+							Computed:    true,
+							Description: "The reason the replication to the target workspace failed.",
+							Type:        schema.TypeString,
+						},
+						Attr_Status: {
+							Computed:    true,
+							Description: "The status of the image in the target workspace.",
+							Type:        schema.TypeString,
+						},
+						Attr_WorkspaceCRN: {
+							Computed:    true,
+							Description: "The CRN of the target workspace.",
+							Type:        schema.TypeString,
+						},
+					},
+				},
+				Type: schema.TypeList,
+			},
+			Attr_StagingObject: {
+				Computed:    true,
+				Description: "The key of the exported image in the staging bucket.",
+				Type:        schema.TypeString,
+			},
+		},
+	}
+}
+
+func resourceIBMPIImageReplicaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	imageID := d.Get(Arg_ImageID).(string)
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, imageID))
+
+	diags := resourceIBMPIImageReplicaSync(ctx, d, meta, "create", d.Timeout(schema.TimeoutCreate))
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, resourceIBMPIImageReplicaRead(ctx, d, meta)...)
+}
+
+func resourceIBMPIImageReplicaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := piSessionForZone(d, meta)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_image_replica", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.Set(Arg_Zone, sess.Options.Zone)
+
+	cloudInstanceID, imageID, err := splitID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_image_replica", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	imageC := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	image, err := imageC.Get(imageID)
+	if err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), NotFound) {
+			log.Printf("[DEBUG] source image does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "ibm_pi_image_replica", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_ImageID, imageID)
+	if _, ok := d.GetOk(Arg_ImageName); !ok {
+		d.Set(Arg_ImageName, image.Name)
+	}
+
+	replicas := d.Get(Attr_Replicas).([]interface{})
+	for _, r := range replicas {
+		replica := r.(map[string]interface{})
+		replicaImageID := replica[Attr_ImageID].(string)
+		if replicaImageID == "" {
+			continue
+		}
+		targetSess, targetCloudInstanceID, err := imageReplicaTargetSession(meta, replica[Attr_WorkspaceCRN].(string))
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("imageReplicaTargetSession failed: %s", err.Error()), "ibm_pi_image_replica", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		replicaImage, err := instance.NewIBMPIImageClient(ctx, targetSess, targetCloudInstanceID).Get(replicaImageID)
+		if err != nil {
+			if !strings.Contains(strings.ToLower(err.Error()), NotFound) {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "ibm_pi_image_replica", "read")
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			replica[Attr_Status] = State_NotFound
+			replica[Attr_Message] = "the image no longer exists in the target workspace"
+			continue
+		}
+		replica[Attr_Status] = imageReplicaStatus(replicaImage)
+		if replicaImage.State == State_Active {
+			replica[Attr_Message] = ""
+		}
+	}
+	d.Set(Attr_Replicas, replicas)
+
+	return nil
+}
+
+func resourceIBMPIImageReplicaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := resourceIBMPIImageReplicaSync(ctx, d, meta, "update", d.Timeout(schema.TimeoutUpdate))
+	if diags.HasError() {
+		return diags
+	}
+	return append(diags, resourceIBMPIImageReplicaRead(ctx, d, meta)...)
+}
+
+func resourceIBMPIImageReplicaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	for _, r := range d.Get(Attr_Replicas).([]interface{}) {
+		replica := r.(map[string]interface{})
+		if err := deleteIBMPIImageReplica(ctx, meta, replica); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("deleteIBMPIImageReplica failed: %s", err.Error()), "ibm_pi_image_replica", "delete")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMPIImageReplicaCustomizeDiff plans a new replication when a
+// replica recorded in state is not active, so that the next apply repairs
+// failed or deleted replicas.
+func resourceIBMPIImageReplicaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if d.HasChange(Arg_TargetWorkspaceCRNs) {
+		return d.SetNewComputed(Attr_Replicas)
+	}
+	for _, r := range d.Get(Attr_Replicas).([]interface{}) {
+		if r.(map[string]interface{})[Attr_Status].(string) != State_Active {
+			return d.SetNewComputed(Attr_Replicas)
+		}
+	}
+	return nil
+}
+
+// resourceIBMPIImageReplicaSync brings the replicas in line with the target
+// workspaces: it deletes the replicas of removed targets, and exports the
+// source image to the staging bucket and imports it into every target that
+// has no active replica. Failed targets are recorded in the replicas and
+// reported as warnings.
+func resourceIBMPIImageReplicaSync(ctx context.Context, d *schema.ResourceData, meta interface{}, operation string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	oldReplicas, _ := d.GetChange(Attr_Replicas)
+	existing := map[string]map[string]interface{}{}
+	for _, r := range oldReplicas.([]interface{}) {
+		replica := r.(map[string]interface{})
+		existing[replica[Attr_WorkspaceCRN].(string)] = replica
+	}
+
+	targets := flex.ExpandStringList(d.Get(Arg_TargetWorkspaceCRNs).(*schema.Set).List())
+	sort.Strings(targets)
+	wanted := map[string]bool{}
+	for _, crn := range targets {
+		wanted[crn] = true
+	}
+
+	for crn, replica := range existing {
+		if wanted[crn] {
+			continue
+		}
+		if err := deleteIBMPIImageReplica(ctx, meta, replica); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("deleteIBMPIImageReplica failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		delete(existing, crn)
+	}
+
+	var pending []string
+	for _, crn := range targets {
+		if replica, ok := existing[crn]; !ok || replica[Attr_Status].(string) != State_Active {
+			pending = append(pending, crn)
+		}
+	}
+
+	var sess *ibmpisession.IBMPISession
+	var cloudInstanceID, imageID, imageName string
+	if len(pending) > 0 {
+		var err error
+		sess, err = piSessionForZone(d, meta)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		cloudInstanceID, imageID, err = splitID(d.Id())
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("splitID failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+
+		imageName = d.Get(Arg_ImageName).(string)
+		if imageName == "" {
+			image, err := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID).Get(imageID)
+			if err != nil {
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Get failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+				return tfErr.GetDiag()
+			}
+			imageName = *image.Name
+			d.Set(Arg_ImageName, imageName)
+		}
+
+		// Adopt the active replicas that are not recorded, for example after
+		// an import, instead of replicating the image to them again
+		remaining := []string{}
+		for _, crn := range pending {
+			if _, ok := existing[crn]; !ok {
+				replica, err := findIBMPIImageReplica(ctx, meta, crn, imageName, imageID)
+				if err != nil {
+					log.Printf("[DEBUG] failed to look up the replica in %s: %v", crn, err)
+				} else if replica != nil && replica[Attr_Status].(string) == State_Active {
+					existing[crn] = replica
+					continue
+				}
+			}
+			remaining = append(remaining, crn)
+		}
+		pending = remaining
+	}
+
+	if len(pending) > 0 {
+		bucket, folder, _ := strings.Cut(d.Get(Arg_ImageBucketName).(string), "/")
+		region := d.Get(Arg_ImageBucketRegion).(string)
+		accessKey := d.Get(Arg_ImageAccessKey).(string)
+		secretKey := d.Get(Arg_ImageSecretKey).(string)
+		prefix := path.Join(folder, imageReplicaStagingPrefix, imageID)
+		cosClient, err := newIBMPIImageReplicaStagingClient(meta, region, accessKey, secretKey)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("newIBMPIImageReplicaStagingClient failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+
+		exportBucket := bucket + "/" + prefix
+		exportResponse, err := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID).ExportImage(imageID, &models.ExportImage{
+			BucketName: &exportBucket,
+			AccessKey:  &accessKey,
+			Region:     region,
+			SecretKey:  secretKey,
+		})
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ExportImage failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+		if _, err = waitForIBMPIJobCompleted(ctx, jobClient, *exportResponse.ID, timeout); err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("waitForIBMPIJobCompleted failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+
+		stagingObject, err := findIBMPIImageReplicaStagingObject(cosClient, bucket, prefix)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("findIBMPIImageReplicaStagingObject failed: %s", err.Error()), "ibm_pi_image_replica", operation)
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		d.Set(Attr_StagingObject, stagingObject)
+
+		for _, crn := range pending {
+			if replica, ok := existing[crn]; ok {
+				if err := deleteIBMPIImageReplica(ctx, meta, replica); err != nil {
+					log.Printf("[DEBUG] failed to delete the unhealthy replica in %s: %v", crn, err)
+				}
+			}
+			replica := map[string]interface{}{
+				Attr_WorkspaceCRN: crn,
+				Attr_Message:      "",
+			}
+			targetCloudInstanceID, replicaImage, err := importIBMPIImageReplica(ctx, meta, crn, imageID, &models.CreateCosImageImportJob{
+				ImageName:     &imageName,
+				BucketName:    &bucket,
+				BucketAccess:  flex.PtrToString("private"),
+				ImageFilename: &stagingObject,
+				Region:        &region,
+				AccessKey:     accessKey,
+				SecretKey:     secretKey,
+				StorageType:   d.Get(Arg_ImageStorageType).(string),
+				UserTags:      models.Tags{imageReplicaSourceTagPrefix + imageID},
+			}, timeout)
+			replica[Attr_CloudInstanceID] = targetCloudInstanceID
+			replica[Attr_ImageID] = ""
+			replica[Attr_Status] = State_Unknown
+			if replicaImage != nil {
+				replica[Attr_ImageID] = *replicaImage.ImageID
+				replica[Attr_Status] = imageReplicaStatus(replicaImage)
+			}
+			if err != nil {
+				replica[Attr_Status] = State_Failed
+				replica[Attr_Message] = err.Error()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to replicate image %s to workspace %s", imageID, crn),
+					Detail:   err.Error(),
+				})
+			}
+			existing[crn] = replica
+		}
+
+		if d.Get(Arg_CleanupStaging).(bool) {
+			if err := deleteIBMPIImageReplicaStagingObjects(cosClient, bucket, prefix); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Failed to delete the staging objects of image %s", imageID),
+					Detail:   err.Error(),
+				})
+			} else {
+				d.Set(Attr_StagingObject, "")
+			}
+		}
+	}
+
+	replicas := make([]interface{}, 0, len(targets))
+	for _, crn := range targets {
+		replicas = append(replicas, existing[crn])
+	}
+	d.Set(Attr_Replicas, replicas)
+
+	return diags
+}
+
+// imageReplicaTargetSession returns the session and GUID of the workspace
+// identified by crn.
+func imageReplicaTargetSession(meta interface{}, crn string) (*ibmpisession.IBMPISession, string, error) {
+	parsed, err := flex.Parse(crn)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid workspace CRN %s: %w", crn, err)
+	}
+	if parsed.ServiceInstance == "" || parsed.Region == "" {
+		return nil, "", fmt.Errorf("invalid workspace CRN %s: the zone and service instance are required", crn)
+	}
+	sess, err := meta.(conns.ClientSession).IBMPISessionForZone(parsed.Region)
+	if err != nil {
+		return nil, "", err
+	}
+	return sess, parsed.ServiceInstance, nil
+}
+
+// importIBMPIImageReplica imports the staged image of sourceImageID into the
+// workspace identified by crn and returns the GUID of the workspace and the
+// imported image.
+func importIBMPIImageReplica(ctx context.Context, meta interface{}, crn, sourceImageID string, body *models.CreateCosImageImportJob, timeout time.Duration) (string, *models.Image, error) {
+	sess, cloudInstanceID, err := imageReplicaTargetSession(meta, crn)
+	if err != nil {
+		return "", nil, err
+	}
+
+	client := instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID)
+	jobResponse, err := client.CreateCosImage(body)
+	if err != nil {
+		return cloudInstanceID, nil, err
+	}
+	jobClient := instance.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	if _, err = waitForIBMPIJobCompleted(ctx, jobClient, *jobResponse.ID, timeout); err != nil {
+		return cloudInstanceID, nil, err
+	}
+
+	image, err := findIBMPIImageReplicaImage(client, *body.ImageName, sourceImageID)
+	if err != nil {
+		return cloudInstanceID, nil, err
+	}
+	if image == nil {
+		return cloudInstanceID, nil, fmt.Errorf("the image imported into workspace %s was not found", cloudInstanceID)
+	}
+	return cloudInstanceID, image, nil
+}
+
+// findIBMPIImageReplica returns the replica of sourceImageID in the workspace
+// identified by crn, or nil when there is none.
+func findIBMPIImageReplica(ctx context.Context, meta interface{}, crn, imageName, sourceImageID string) (map[string]interface{}, error) {
+	sess, cloudInstanceID, err := imageReplicaTargetSession(meta, crn)
+	if err != nil {
+		return nil, err
+	}
+	image, err := findIBMPIImageReplicaImage(instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID), imageName, sourceImageID)
+	if err != nil || image == nil {
+		return nil, err
+	}
+	return map[string]interface{}{
+		Attr_CloudInstanceID: cloudInstanceID,
+		Attr_ImageID:         *image.ImageID,
+		Attr_Message:         "",
+		Attr_Status:          imageReplicaStatus(image),
+		Attr_WorkspaceCRN:    crn,
+	}, nil
+}
+
+// findIBMPIImageReplicaImage returns the image named imageName that carries
+// the source tag of sourceImageID, or nil when there is none. Other images of
+// the same name are ignored.
+func findIBMPIImageReplicaImage(client *instance.IBMPIImageClient, imageName, sourceImageID string) (*models.Image, error) {
+	images, err := client.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, ref := range images.Images {
+		if ref == nil || ref.ImageID == nil || flex.StringValue(ref.Name) != imageName {
+			continue
+		}
+		image, err := client.Get(*ref.ImageID)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range image.UserTags {
+			if tag == imageReplicaSourceTagPrefix+sourceImageID {
+				return image, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// imageReplicaStatus returns the state of a replica image, or unknown when
+// the state is not reported. Only an active replica is left alone on apply.
+func imageReplicaStatus(image *models.Image) string {
+	if image.State == "" {
+		return State_Unknown
+	}
+	return image.State
+}
+
+// deleteIBMPIImageReplica deletes the image of a replica from its target
+// workspace, ignoring images that no longer exist.
+func deleteIBMPIImageReplica(ctx context.Context, meta interface{}, replica map[string]interface{}) error {
+	imageID, _ := replica[Attr_ImageID].(string)
+	if imageID == "" {
+		return nil
+	}
+	sess, cloudInstanceID, err := imageReplicaTargetSession(meta, replica[Attr_WorkspaceCRN].(string))
+	if err != nil {
+		return err
+	}
+	err = instance.NewIBMPIImageClient(ctx, sess, cloudInstanceID).Delete(imageID)
+	if err != nil && !strings.Contains(strings.ToLower(err.Error()), NotFound) {
+		return err
+	}
+	return nil
+}
+
+// newIBMPIImageReplicaStagingClient returns a client for the staging bucket
+// that authenticates with the same HMAC keys the export and import jobs use.
+// The endpoint follows the provider visibility, and can be overridden with the
+// endpoints file or IBMCLOUD_COS_ENDPOINT like the other COS clients.
+func newIBMPIImageReplicaStagingClient(meta interface{}, region, accessKey, secretKey string) (*s3.S3, error) {
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	visibility := "public"
+	endpoint := fmt.Sprintf("s3.%s.cloud-object-storage.appdomain.cloud", region)
+	if bxSession.Config.Visibility == "private" {
+		visibility = "private"
+		endpoint = fmt.Sprintf("s3.private.%s.cloud-object-storage.appdomain.cloud", region)
+	}
+	endpoint = conns.FileFallBack(bxSession.Config.EndpointsFile, visibility, "IBMCLOUD_COS_ENDPOINT", region, endpoint)
+	endpoint = conns.EnvFallBack([]string{"IBMCLOUD_COS_ENDPOINT"}, endpoint)
+
+	s3Conf := aws.NewConfig().
+		WithEndpoint(endpoint).
+		WithRegion(region).
+		WithCredentials(credentials.NewStaticCredentials(accessKey, secretKey, "")).
+		WithS3ForcePathStyle(true)
+	s3Sess := session.Must(session.NewSession())
+	return s3.New(s3Sess, s3Conf), nil
+}
+
+// findIBMPIImageReplicaStagingObject returns the key of the exported image
+// below prefix.
+func findIBMPIImageReplicaStagingObject(client *s3.S3, bucket, prefix string) (string, error) {
+	var key string
+	err := client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix + "/"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			if strings.HasSuffix(*object.Key, ".ova.gz") {
+				key = *object.Key
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list the staging objects in bucket %s: %w", bucket, err)
+	}
+	if key == "" {
+		return "", fmt.Errorf("no exported image found in bucket %s below %s", bucket, prefix)
+	}
+	return key, nil
+}
+
+// deleteIBMPIImageReplicaStagingObjects deletes every object below prefix.
+func deleteIBMPIImageReplicaStagingObjects(client *s3.S3, bucket, prefix string) error {
+	var objects []*s3.ObjectIdentifier
+	err := client.ListObjectsV2Pages(&s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(prefix + "/"),
+	}, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			objects = append(objects, &s3.ObjectIdentifier{Key: object.Key})
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for len(objects) > 0 {
+		batch := objects
+		if len(batch) > 1000 {
+			batch = batch[:1000]
+		}
+		objects = objects[len(batch):]
+		if _, err := client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3.Delete{Objects: batch, Quiet: aws.Bool(true)},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
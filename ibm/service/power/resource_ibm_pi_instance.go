@@ -123,6 +123,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 				}
 				return nil
 			},
+			resourceIBMPIInstanceCapacityCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -170,6 +171,7 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:    true,
 				Type:        schema.TypeBool,
 			},
+			Arg_CapacityCheck: capacityCheckSchema(),
 			Arg_CloudInstanceID: {
 				Description: "This is the Power Instance id that is assigned to the account",
 				ForceNew:    true,
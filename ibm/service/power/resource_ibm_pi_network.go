@@ -17,7 +17,6 @@ import (
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_networks"
 	"github.com/IBM-Cloud/power-go-client/power/models"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/apparentlymart/go-cidr/cidr"
@@ -34,7 +33,9 @@ func ResourceIBMPINetwork() *schema.Resource {
 		ReadContext:   resourceIBMPINetworkRead,
 		UpdateContext: resourceIBMPINetworkUpdate,
 		DeleteContext: resourceIBMPINetworkDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIZonalImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(15 * time.Minute),
@@ -185,6 +186,8 @@ func ResourceIBMPINetwork() *schema.Resource {
 				Type:        schema.TypeSet,
 			},
 
+			Arg_Zone: resourceIBMPIZoneSchema(),
+
 			// Attributes
 			Attr_CRN: {
 				Computed:    true,
@@ -233,7 +236,7 @@ func ResourceIBMPINetwork() *schema.Resource {
 }
 
 func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -351,7 +354,7 @@ func resourceIBMPINetworkCreate(ctx context.Context, d *schema.ResourceData, met
 }
 
 func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -399,6 +402,7 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set(Arg_Gateway, networkdata.Gateway)
 	d.Set(Arg_NetworkMTU, networkdata.Mtu)
 	d.Set(Arg_NetworkName, networkdata.Name)
+	d.Set(Arg_Zone, sess.Options.Zone)
 	d.Set(Arg_NetworkType, networkdata.Type)
 	d.Set(Attr_EnableDHCP, networkdata.EnableDHCP)
 	d.Set(Attr_NetworkID, networkdata.NetworkID)
@@ -428,7 +432,7 @@ func resourceIBMPINetworkRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -502,7 +506,7 @@ func resourceIBMPINetworkUpdate(ctx context.Context, d *schema.ResourceData, met
 
 func resourceIBMPINetworkDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	log.Printf("Calling the network delete functions. ")
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -14,7 +14,6 @@ import (
 	"github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
 	"github.com/IBM-Cloud/power-go-client/power/models"
-	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,7 +29,9 @@ func ResourceIBMPIVolume() *schema.Resource {
 		ReadContext:   resourceIBMPIVolumeRead,
 		UpdateContext: resourceIBMPIVolumeUpdate,
 		DeleteContext: resourceIBMPIVolumeDelete,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMPIZonalImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -41,6 +42,7 @@ func ResourceIBMPIVolume() *schema.Resource {
 			func(_ context.Context, diff *schema.ResourceDiff, v any) error {
 				return flex.ResourcePowerUserTagsCustomizeDiff(diff)
 			},
+			resourceIBMPIVolumeCapacityCustomizeDiff,
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -82,6 +84,7 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Optional:         true,
 				Type:             schema.TypeList,
 			},
+			Arg_CapacityCheck: capacityCheckSchema(),
 			Arg_CloudInstanceID: {
 				Description:  "The GUID of the service instance associated with an account.",
 				ForceNew:     true,
@@ -144,6 +147,8 @@ func ResourceIBMPIVolume() *schema.Resource {
 				ValidateFunc:     validate.ValidateAllowedStringValues([]string{"tier0", "tier1", "tier3", "tier5k"}),
 			},
 
+			Arg_Zone: resourceIBMPIZoneSchema(),
+
 			// Attributes
 			Attr_Auxiliary: {
 				Computed:    true,
@@ -251,7 +256,7 @@ func ResourceIBMPIVolumeValidator() *validate.ResourceValidator {
 }
 
 func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume", "create")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -351,7 +356,7 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume", "read")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -391,6 +396,7 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta a
 		d.Set(Arg_UserTags, tags)
 	}
 	d.Set(Arg_VolumeName, vol.Name)
+	d.Set(Arg_Zone, sess.Options.Zone)
 	d.Set(Arg_VolumePool, vol.VolumePool)
 	if vol.Shareable != nil {
 		d.Set(Arg_VolumeShareable, vol.Shareable)
@@ -421,7 +427,7 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta a
 }
 
 func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume", "update")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -497,7 +503,7 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceIBMPIVolumeDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
-	sess, err := meta.(conns.ClientSession).IBMPISession()
+	sess, err := piSessionForZone(d, meta)
 	if err != nil {
 		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("IBMPISession failed: %s", err.Error()), "ibm_pi_volume", "delete")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceIBMPIZoneSchema is the optional zone override of a Power Systems
+// resource. It defaults to the provider zone.
+func resourceIBMPIZoneSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The zone of the workspace. Defaults to the provider zone.",
+		ForceNew:    true,
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
+// dataSourceIBMPIZoneSchema is the optional zone override of a Power Systems
+// data source. It defaults to the provider zone.
+func dataSourceIBMPIZoneSchema() *schema.Schema {
+	return &schema.Schema{
+		Computed:    true,
+		Description: "The zone of the workspace. Defaults to the provider zone.",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
+}
+
+// piSessionForZone returns the session for the zone selected by the pi_zone
+// argument of d, or the provider session when none is selected or the
+// resource has no pi_zone argument. d is a *schema.ResourceData or, at plan
+// time, a *schema.ResourceDiff.
+func piSessionForZone(d interface{ Get(string) interface{} }, meta interface{}) (*ibmpisession.IBMPISession, error) {
+	zone, _ := d.Get(Arg_Zone).(string)
+	return meta.(conns.ClientSession).IBMPISessionForZone(zone)
+}
+
+// resourceIBMPIZonalImport accepts either <cloud_instance_id>/<id> or
+// <zone>/<cloud_instance_id>/<id>, the latter importing a resource from a
+// workspace outside the provider zone.
+func resourceIBMPIZonalImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if parts := strings.Split(d.Id(), "/"); len(parts) == 3 {
+		if err := d.Set(Arg_Zone, parts[0]); err != nil {
+			return nil, err
+		}
+		d.SetId(parts[1] + "/" + parts[2])
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,6 +4,7 @@
 package satellite
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -18,6 +19,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	hostOSRHEL8 = "RHEL8"
+	hostOSRHEL9 = "RHEL9"
+	hostOSRHCOS = "RHCOS"
+
+	attachHostScriptPath = "/usr/local/bin/ibm-satellite-attach-host.sh"
 )
 
 func DataSourceIBMSatelliteAttachHostScript() *schema.Resource {
@@ -36,9 +47,17 @@ func DataSourceIBMSatelliteAttachHostScript() *schema.Resource {
 				Description: "A unique name for the new Satellite location",
 			},
 			"coreos_host": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				Description: "If true, returns a CoreOS ignition file for the host. Otherwise, returns a RHEL attach script",
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"operating_system"},
+				Description:   "If true, returns a CoreOS ignition file for the host. Otherwise, returns a RHEL attach script",
+			},
+			"operating_system": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"coreos_host"},
+				ValidateFunc:  validate.InvokeDataSourceValidator("ibm_satellite_attach_host_script", "operating_system"),
+				Description:   "The operating system of the host: RHEL8, RHEL9 or RHCOS. RHCOS returns a CoreOS ignition file, otherwise a RHEL attach script is returned. RHEL8 and RHEL9 set the RHEL release in the script, instead of the release detected on the host, so that the script enables the repositories of that release",
 			},
 			"labels": {
 				Type:        schema.TypeSet,
@@ -68,6 +87,11 @@ func DataSourceIBMSatelliteAttachHostScript() *schema.Resource {
 				Computed:    true,
 				Description: "Attach host script content",
 			},
+			"user_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The attach host script rendered as user data for the host: a cloud-config document for RHEL hosts and the ignition file for CoreOS hosts",
+			},
 			"custom_script": {
 				Description:  "The custom script that has to be appended to generated host script file",
 				Type:         schema.TypeString,
@@ -145,20 +169,16 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 
 	//check to see if host attach is CoreOS or RHEL
 	var host_os string
-	var coreos_enabled bool
-	if _, ok := d.GetOk("coreos_host"); ok {
-		coreos_enabled = d.Get("coreos_host").(bool)
-		if coreos_enabled {
-			host_os = "RHCOS"
-			createRegOptions.OperatingSystem = &host_os
-			scriptPath = filepath.Join(scriptDir, "addHost.ign")
-		}
+	operatingSystem := d.Get("operating_system").(string)
+	coreos_enabled := d.Get("coreos_host").(bool) || operatingSystem == hostOSRHCOS
+	if coreos_enabled {
+		host_os = "RHCOS"
+		scriptPath = filepath.Join(scriptDir, "addHost.ign")
 	} else {
-		coreos_enabled = false
 		host_os = "RHEL"
-		createRegOptions.OperatingSystem = &host_os
 		scriptPath = filepath.Join(scriptDir, "addHost.sh")
 	}
+	createRegOptions.OperatingSystem = &host_os
 
 	// If the user supplied link agent endpoint, use reduced firewall attach script
 	if hlae, ok := d.GetOk("host_link_agent_endpoint"); ok {
@@ -173,27 +193,58 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 
 	scriptContent := string(resp)
 
-	//if this is a RHEL host, find insert point for custom code
+	//if this is a RHEL host, insert the custom code
 	if !coreos_enabled {
-		lines := strings.Split(scriptContent, "\n")
-		var index int
-		for i, line := range lines {
-			if strings.Contains(line, `export OPERATING_SYSTEM`) {
-				index = i
-				break
-			}
+		customScript, _ := d.Get("custom_script").(string)
+		scriptContent = customizeAttachHostScript(scriptContent, hostProvider, customScript, operatingSystem)
+	}
+
+	err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
+	}
+
+	d.Set("location", location)
+	d.Set("host_script", scriptContent)
+	d.Set("user_data", renderAttachHostUserData(scriptContent, coreos_enabled))
+	d.Set("host_provider", hostProvider)
+	d.Set("script_dir", scriptDir)
+	d.Set("script_path", scriptPath)
+	d.SetId(*locData.ID)
+
+	log.Printf("[INFO] Generated satellite location script : %s", *locData.Name)
+
+	return nil
+}
+
+// customizeAttachHostScript inserts the host provider specific setup, or the
+// custom script, into a RHEL attach script after the detection of the
+// operating system. A RHEL8 or RHEL9 operatingSystem overrides the detected
+// release, so that the script enables the repositories of that release.
+func customizeAttachHostScript(scriptContent, hostProvider, customScript, operatingSystem string) string {
+	lines := strings.Split(scriptContent, "\n")
+	var index int
+	for i, line := range lines {
+		if strings.Contains(line, `export OPERATING_SYSTEM`) {
+			index = i
+			break
 		}
+	}
+
+	if operatingSystem == hostOSRHEL8 || operatingSystem == hostOSRHEL9 {
+		lines[index] = lines[index] + "\nexport OPERATING_SYSTEM=" + operatingSystem
+	}
 
-		var insertionText string
+	var insertionText string
 
-		switch {
-		case strings.ToLower(hostProvider) == "aws":
-			insertionText = `
+	switch {
+	case strings.ToLower(hostProvider) == "aws":
+		insertionText = `
 yum-config-manager --enable '*'
 yum install container-selinux -y
 `
-		case strings.ToLower(hostProvider) == "ibm":
-			insertionText = `
+	case strings.ToLower(hostProvider) == "ibm":
+		insertionText = `
 subscription-manager refresh
 if [[ "${OPERATING_SYSTEM}" == "RHEL7" ]]; then
 	subscription-manager repos --enable rhel-server-rhscl-7-rpms
@@ -206,48 +257,66 @@ elif [[ "${OPERATING_SYSTEM}" == "RHEL8" ]]; then
 	subscription-manager repos --disable='*eus*'
 	subscription-manager repos --enable rhel-8-for-x86_64-baseos-rpms 
 	subscription-manager repos --enable rhel-8-for-x86_64-appstream-rpms;
+elif [[ "${OPERATING_SYSTEM}" == "RHEL9" ]]; then
+	subscription-manager release --set=9
+	subscription-manager repos --disable='*eus*'
+	subscription-manager repos --enable rhel-9-for-x86_64-baseos-rpms
+	subscription-manager repos --enable rhel-9-for-x86_64-appstream-rpms;
 fi
 yum install container-selinux -y
 `
-		case strings.ToLower(hostProvider) == "azure":
-			insertionText = `
+	case strings.ToLower(hostProvider) == "azure":
+		insertionText = `
 #if [[ "${OPERATING_SYSTEM}" == "RHEL8" ]]; then
 #	update-alternatives --install /usr/bin/python3 python3 /usr/bin/python3.8 1
 #	update-alternatives --set python3 /usr/bin/python3.8
 #fi
 yum install container-selinux -y
 `
-		case strings.ToLower(hostProvider) == "google":
-			insertionText = `
+	case strings.ToLower(hostProvider) == "google":
+		insertionText = `
 #if [[ "${OPERATING_SYSTEM}" == "RHEL8" ]]; then
 #	update-alternatives --install /usr/bin/python3 python3 /usr/bin/python3.8 1
 #	update-alternatives --set python3 /usr/bin/python3.8
 #fi
 yum install container-selinux -y
 `
-		default:
-			if script, ok := d.GetOk("custom_script"); ok {
-				insertionText = script.(string)
-			}
-		}
-
-		lines[index] = lines[index] + "\n" + insertionText
-		scriptContent = strings.Join(lines, "\n")
+	default:
+		insertionText = customScript
 	}
 
-	err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
-	if err != nil {
-		return fmt.Errorf("[ERROR] Error Creating Satellite Attach Host Script: %s", err)
-	}
+	lines[index] = lines[index] + "\n" + insertionText
+	return strings.Join(lines, "\n")
+}
 
-	d.Set("location", location)
-	d.Set("host_script", scriptContent)
-	d.Set("host_provider", hostProvider)
-	d.Set("script_dir", scriptDir)
-	d.Set("script_path", scriptPath)
-	d.SetId(*locData.ID)
+func DataSourceIBMSatelliteAttachHostScriptValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "operating_system",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              fmt.Sprintf("%s, %s, %s", hostOSRHEL8, hostOSRHEL9, hostOSRHCOS)})
 
-	log.Printf("[INFO] Generated satellite location script : %s", *locData.Name)
+	iBMSatelliteAttachHostScriptValidator := validate.ResourceValidator{ResourceName: "ibm_satellite_attach_host_script", Schema: validateSchema}
+	return &iBMSatelliteAttachHostScriptValidator
+}
 
-	return nil
+// renderAttachHostUserData returns user data that attaches the host on first
+// boot. CoreOS hosts consume the ignition file directly, RHEL hosts get a
+// cloud-config document that writes and runs the attach script.
+func renderAttachHostUserData(script string, coreos bool) string {
+	if coreos {
+		return script
+	}
+	return fmt.Sprintf(`#cloud-config
+write_files:
+  - path: %[1]s
+    permissions: "0700"
+    encoding: b64
+    content: %[2]s
+runcmd:
+  - [ "bash", "%[1]s" ]
+`, attachHostScriptPath, base64.StdEncoding.EncodeToString([]byte(script)))
 }
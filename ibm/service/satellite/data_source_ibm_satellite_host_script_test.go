@@ -5,6 +5,7 @@ package satellite_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
@@ -79,3 +80,36 @@ data "ibm_satellite_attach_host_script" "script" {
 	host_link_agent_endpoint = "testendpoint"
 }`, locationName)
 }
+
+func TestAccIBMSatelliteAttachHostScriptDataSourceUserData(t *testing.T) {
+	locationName := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteAttachHostScriptDataSourceConfigUserData(locationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_satellite_attach_host_script.script", "operating_system", "RHEL9"),
+					resource.TestMatchResourceAttr("data.ibm_satellite_attach_host_script.script", "user_data", regexp.MustCompile("^#cloud-config\n")),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteAttachHostScriptDataSourceConfigUserData(locationName string) string {
+	return fmt.Sprintf(`
+resource "ibm_satellite_location" "testacc_satellite" {
+	location     = "%s"
+	managed_from = "wdc04"
+	zones		 = ["us-east-1", "us-east-2", "us-east-3"]
+}
+
+data "ibm_satellite_attach_host_script" "script" {
+	location         = ibm_satellite_location.testacc_satellite.id
+	labels           = ["env:prod"]
+	host_provider    = "ibm"
+	operating_system = "RHEL9"
+}`, locationName)
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestRenderAttachHostUserDataRHEL(t *testing.T) {
+	script := "#!/usr/bin/env bash\necho attach\n"
+	userData := renderAttachHostUserData(script, false)
+
+	if !strings.HasPrefix(userData, "#cloud-config\n") {
+		t.Fatalf("expected a cloud-config document, got:\n%s", userData)
+	}
+	if !strings.Contains(userData, "path: "+attachHostScriptPath) {
+		t.Errorf("expected the script to be written to %s, got:\n%s", attachHostScriptPath, userData)
+	}
+	if !strings.Contains(userData, `[ "bash", "`+attachHostScriptPath+`" ]`) {
+		t.Errorf("expected the script to be run on first boot, got:\n%s", userData)
+	}
+	if !strings.Contains(userData, "content: "+base64.StdEncoding.EncodeToString([]byte(script))) {
+		t.Errorf("expected the script to be base64 encoded, got:\n%s", userData)
+	}
+}
+
+func TestRenderAttachHostUserDataCoreOS(t *testing.T) {
+	ignition := `{"ignition":{"version":"3.2.0"}}`
+	if userData := renderAttachHostUserData(ignition, true); userData != ignition {
+		t.Errorf("expected the ignition file unchanged, got %q", userData)
+	}
+}
+
+func TestSatelliteHostMatchesVirtualGuest(t *testing.T) {
+	cases := []struct {
+		hostName string
+		want     bool
+	}{
+		{"host-1", true},
+		{"HOST-1", true},
+		{"host-1.example.com", true},
+		{"host-1.other.com", false},
+		{"host-2", false},
+	}
+	for _, c := range cases {
+		if got := satelliteHostMatchesVirtualGuest(c.hostName, "host-1", "host-1.example.com"); got != c.want {
+			t.Errorf("%s: got %t, want %t", c.hostName, got, c.want)
+		}
+	}
+}
+
+func TestCustomizeAttachHostScriptOperatingSystem(t *testing.T) {
+	script := "#!/usr/bin/env bash\nexport OPERATING_SYSTEM=$(detect_os)\necho attach\n"
+
+	rhel8 := customizeAttachHostScript(script, "ibm", "", hostOSRHEL8)
+	rhel9 := customizeAttachHostScript(script, "ibm", "", hostOSRHEL9)
+	if rhel8 == rhel9 {
+		t.Fatal("expected different scripts for RHEL8 and RHEL9")
+	}
+	if !strings.Contains(rhel8, "export OPERATING_SYSTEM=$(detect_os)\nexport OPERATING_SYSTEM=RHEL8\n\nsubscription-manager refresh") {
+		t.Errorf("expected RHEL8 to be set after the detection and before the repositories are enabled, got:\n%s", rhel8)
+	}
+	if !strings.Contains(rhel9, "export OPERATING_SYSTEM=RHEL9\n") {
+		t.Errorf("expected RHEL9 to be set, got:\n%s", rhel9)
+	}
+
+	detected := customizeAttachHostScript(script, "ibm", "", "")
+	if strings.Contains(detected, "export OPERATING_SYSTEM=RHEL") {
+		t.Errorf("expected the detected release to be kept without operating_system, got:\n%s", detected)
+	}
+}
+
+func TestCustomizeAttachHostScriptCustomScript(t *testing.T) {
+	script := "#!/usr/bin/env bash\nexport OPERATING_SYSTEM=$(detect_os)\necho attach\n"
+	customized := customizeAttachHostScript(script, "", "echo custom", "")
+	if !strings.Contains(customized, "export OPERATING_SYSTEM=$(detect_os)\necho custom\necho attach") {
+		t.Errorf("expected the custom script after the detection, got:\n%s", customized)
+	}
+}
@@ -4,8 +4,10 @@
 package satellite
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
@@ -14,6 +16,7 @@ import (
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/softlayer/softlayer-go/services"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 )
@@ -27,11 +30,18 @@ const (
 	hostZone       = "zone"
 	hostWorkerPool = "worker_pool"
 	hostProvider   = "host_provider"
+	hostReload     = "reload_on_destroy"
+	hostReloadVSI  = "reload_virtual_guest_id"
 
 	rsHostNormalStatus       = "normal"
 	rsHostProvisioningStatus = "provisioning"
 	rsHostReadyStatus        = "ready"
 	rsHostUnknownStatus      = "unknown"
+	rsHostUnresponsiveStatus = "unresponsive"
+
+	rsHostAssignedState   = "assigned"
+	rsHostUnassignedState = "unassigned"
+	rsHostRemovedState    = "removed"
 )
 
 func ResourceIBMSatelliteHost() *schema.Resource {
@@ -42,6 +52,8 @@ func ResourceIBMSatelliteHost() *schema.Resource {
 		Delete:   resourceIBMSatelliteHostDelete,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: resourceIBMSatelliteHostReloadCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(75 * time.Minute),
 			Read:   schema.DefaultTimeout(75 * time.Minute),
@@ -96,6 +108,18 @@ func ResourceIBMSatelliteHost() *schema.Resource {
 				Computed:    true,
 				Description: "Health status of the host",
 			},
+			hostReload: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reload the operating system of the host after it is removed from the location, so that it can be attached again. Supported for IBM Cloud classic virtual server instances, requires reload_virtual_guest_id",
+			},
+			hostReloadVSI: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{hostReload},
+				Description:  "The ID of the IBM Cloud classic virtual server instance of the host, reloaded when reload_on_destroy is true. The reload fails if the hostname of the instance does not match the host name",
+			},
 			"wait_till": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -173,8 +197,8 @@ func resourceIBMSatelliteHostCreate(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(fmt.Sprintf("%s/%s", location, hostNameOrID))
 
-	//Wait for host to reach normal state
-	_, err = waitForHostAttachment(hostNameOrID, location, d, meta)
+	//Wait for host to be assigned and reach normal state
+	_, err = waitForHostAssignment(hostNameOrID, location, d, meta)
 	if err != nil {
 		return fmt.Errorf("[ERROR] Error waiting for host (%s) to get normal state: %s", hostNameOrID, err)
 	}
@@ -294,6 +318,12 @@ func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// The host name is needed to reload the host once it is no longer listed
+	hostName := hostNameorID
+	if host, err := getSatelliteHost(satClient, location, hostNameorID); err == nil && host != nil {
+		hostName = flex.StringValue(host.Name)
+	}
+
 	removeSatHostOptions := &kubernetesserviceapiv1.RemoveSatelliteHostOptions{}
 	removeSatHostOptions.Controller = &location
 	removeSatHostOptions.HostID = &hostNameorID
@@ -306,10 +336,146 @@ func resourceIBMSatelliteHostDelete(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("[ERROR] Error Deleting Satellite Host: %s\n%s", err, response)
 	}
 
+	_, err = waitForHostRemoval(hostNameorID, location, d, meta)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error waiting for host (%s) to be removed from location (%s): %s", hostNameorID, location, err)
+	}
+
+	if d.Get(hostReload).(bool) {
+		if err := reloadSatelliteHost(d.Get(hostReloadVSI).(int), hostName, meta); err != nil {
+			return fmt.Errorf("[ERROR] Error reloading Satellite host (%s): %s", hostName, err)
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
 
+func getSatelliteHost(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location, hostNameOrID string) (*kubernetesserviceapiv1.MultishiftQueueNode, error) {
+	hostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	}
+	hostList, resp, err := satClient.GetSatelliteHosts(hostOptions)
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("[ERROR] Error getting hosts of location (%s): %s\n%s", location, err, resp)
+	}
+	for _, h := range hostList {
+		if hostNameOrID == flex.StringValue(h.Name) || hostNameOrID == flex.StringValue(h.ID) {
+			host := h
+			return &host, nil
+		}
+	}
+	return nil, nil
+}
+
+// waitForHostAssignment waits for an assigned host to become normal. Right
+// after the assignment the host still reports the ready health of an unassigned host.
+func waitForHostAssignment(hostNameOrID, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostUnassignedState, rsHostProvisioningStatus, rsHostReadyStatus, rsHostUnknownStatus, rsHostUnresponsiveStatus},
+		Target:  []string{rsHostNormalStatus},
+		Refresh: func() (interface{}, string, error) {
+			host, err := getSatelliteHost(satClient, location, hostNameOrID)
+			if err != nil {
+				return nil, "", err
+			}
+			if host == nil || host.Health == nil {
+				return hostNameOrID, rsHostUnknownStatus, nil
+			}
+			if flex.StringValue(host.State) != rsHostAssignedState {
+				return host, rsHostUnassignedState, nil
+			}
+			status := flex.StringValue(host.Health.Status)
+			switch status {
+			case rsHostNormalStatus, rsHostReadyStatus, rsHostProvisioningStatus, rsHostUnknownStatus, rsHostUnresponsiveStatus:
+				return host, status, nil
+			}
+			return host, status, fmt.Errorf("[ERROR] The satellite host (%s) is %s: %s", hostNameOrID, status, flex.StringValue(host.Health.Message))
+		},
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 30 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+func waitForHostRemoval(hostNameOrID, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{rsHostAssignedState, rsHostUnassignedState},
+		Target:  []string{rsHostRemovedState},
+		Refresh: func() (interface{}, string, error) {
+			host, err := getSatelliteHost(satClient, location, hostNameOrID)
+			if err != nil {
+				return nil, "", err
+			}
+			if host == nil {
+				return hostNameOrID, rsHostRemovedState, nil
+			}
+			if flex.StringValue(host.State) == rsHostAssignedState {
+				return host, rsHostAssignedState, nil
+			}
+			return host, rsHostUnassignedState, nil
+		},
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	return stateConf.WaitForState()
+}
+
+// resourceIBMSatelliteHostReloadCustomizeDiff requires the ID of the classic
+// virtual server instance to reload, so that a reload never picks an instance
+// by its name.
+func resourceIBMSatelliteHostReloadCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Get(hostReload).(bool) && diff.Get(hostReloadVSI).(int) == 0 {
+		return fmt.Errorf("[ERROR] %s is required when %s is true", hostReloadVSI, hostReload)
+	}
+	return nil
+}
+
+// reloadSatelliteHost reloads the operating system of the classic virtual
+// server instance guestID, after checking that it is the Satellite host.
+func reloadSatelliteHost(guestID int, hostName string, meta interface{}) error {
+	sess := meta.(conns.ClientSession).SoftLayerSession()
+	guestService := services.GetVirtualGuestService(sess).Id(guestID)
+	vg, err := guestService.Mask("id,hostname,domain,fullyQualifiedDomainName").GetObject()
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error retrieving virtual guest %d: %s", guestID, err)
+	}
+	if !satelliteHostMatchesVirtualGuest(hostName, flex.StringValue(vg.Hostname), flex.StringValue(vg.FullyQualifiedDomainName)) {
+		return fmt.Errorf("[ERROR] Virtual guest %d (%s) is not the host %s, it is not reloaded", guestID, flex.StringValue(vg.FullyQualifiedDomainName), hostName)
+	}
+
+	log.Printf("[INFO] Reloading the operating system of virtual guest %d (%s)", guestID, flex.StringValue(vg.FullyQualifiedDomainName))
+	_, err = guestService.ReloadCurrentOperatingSystemConfiguration()
+	return err
+}
+
+// satelliteHostMatchesVirtualGuest reports whether the Satellite host name is
+// the fully qualified domain name of a virtual guest, or its hostname when the
+// host name is not qualified.
+func satelliteHostMatchesVirtualGuest(hostName, guestHostname, guestFQDN string) bool {
+	if strings.Contains(hostName, ".") {
+		return strings.EqualFold(hostName, guestFQDN)
+	}
+	return strings.EqualFold(hostName, guestHostname)
+}
+
 func waitForHostAttachment(hostNameOrID, location string, d *schema.ResourceData, meta interface{}) (interface{}, error) {
 	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
 	if err != nil {
@@ -0,0 +1,346 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ action.Action              = &smSecretRotateAction{}
+	_ action.ActionWithConfigure = &smSecretRotateAction{}
+)
+
+// Interval between two checks of the state of a rotated secret
+var secretRotatePollInterval = 5 * time.Second
+
+func NewSmSecretRotateAction() action.Action {
+	return &smSecretRotateAction{}
+}
+
+type smSecretRotateAction struct {
+	session conns.ClientSession
+}
+
+type smSecretRotateModel struct {
+	InstanceID            types.String `tfsdk:"instance_id"`
+	Region                types.String `tfsdk:"region"`
+	EndpointType          types.String `tfsdk:"endpoint_type"`
+	SecretID              types.String `tfsdk:"secret_id"`
+	Payload               types.String `tfsdk:"payload"`
+	ExpirePreviousVersion types.Bool   `tfsdk:"expire_previous_version"`
+	WaitTimeout           types.Int64  `tfsdk:"wait_timeout"`
+	NoWait                types.Bool   `tfsdk:"no_wait"`
+}
+
+// The secret fields the action needs, common to the metadata of all secret types
+type smRotatedSecretMetadata struct {
+	Name             string `json:"name"`
+	SecretType       string `json:"secret_type"`
+	StateDescription string `json:"state_description"`
+}
+
+type smRotatedSecretVersion struct {
+	ID    string `json:"id"`
+	Alias string `json:"alias"`
+}
+
+func (a *smSecretRotateAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = "ibm_sm_secret_rotate"
+}
+
+func (a *smSecretRotateAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Rotates a Secrets Manager secret immediately by creating a new version of it, for example after a suspected leak. Supports arbitrary, kv, iam_credentials, username_password and private_cert secrets. Actions do not return output values.",
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Secrets Manager instance.",
+			},
+			"region": schema.StringAttribute{
+				Optional:    true,
+				Description: "The region of the Secrets Manager instance. If not specified, defaults to the region of the provider configuration.",
+			},
+			"endpoint_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "public or private. If not specified, defaults to the visibility of the provider configuration.",
+			},
+			"secret_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the secret to rotate.",
+			},
+			"payload": schema.StringAttribute{
+				Optional:    true,
+				Description: "The new payload of the secret. Required for arbitrary secrets, and for kv secrets, where it must be a JSON object. Not supported for other secret types, whose new version is generated by Secrets Manager.",
+			},
+			"expire_previous_version": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the data of the previous version is deleted once the new version is active. For iam_credentials secrets this deletes the API key of the previous version. Default: false",
+			},
+			"wait_timeout": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum time in seconds to wait for the new version to be active. If not specified, defaults to 3600 seconds. Ignored when no_wait is true.",
+			},
+			"no_wait": schema.BoolAttribute{
+				Optional:    true,
+				Description: "If true, the action returns immediately after creating the new version without waiting for it to be active. Cannot be combined with expire_previous_version. Default: false",
+			},
+		},
+	}
+}
+
+func (a *smSecretRotateAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	session, ok := req.ProviderData.(conns.ClientSession)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Provider Data Type",
+			fmt.Sprintf("Expected conns.ClientSession, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	a.session = session
+}
+
+func (a *smSecretRotateAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config smSecretRotateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secretID := config.SecretID.ValueString()
+	noWait := !config.NoWait.IsNull() && config.NoWait.ValueBool()
+	expirePrevious := !config.ExpirePreviousVersion.IsNull() && config.ExpirePreviousVersion.ValueBool()
+	if noWait && expirePrevious {
+		resp.Diagnostics.AddError(
+			"Invalid Action Configuration",
+			"expire_previous_version cannot be combined with no_wait, the previous version can only be expired once the new version is active.",
+		)
+		return
+	}
+
+	waitTimeout := 3600 * time.Second
+	if !config.WaitTimeout.IsNull() {
+		waitTimeout = time.Duration(config.WaitTimeout.ValueInt64()) * time.Second
+	}
+
+	client, err := GetClientForInstance(a.session, config.InstanceID.ValueString(), config.Region.ValueString(), config.EndpointType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Secrets Manager Client",
+			"An unexpected error occurred when creating the Secrets Manager client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Secrets Manager Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	secret, err := getRotatedSecretMetadata(ctx, client, secretID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Secret Lookup Failed",
+			err.Error(),
+		)
+		return
+	}
+
+	prototype, err := secretVersionPrototypeForRotation(secret.SecretType, config.Payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unsupported Rotation",
+			fmt.Sprintf("Cannot rotate secret '%s': %s", secretID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Rotating %s secret '%s' (%s)", secret.SecretType, secret.Name, secretID),
+	})
+
+	versionIntf, response, err := client.CreateSecretVersionWithContext(ctx, &secretsmanagerv2.CreateSecretVersionOptions{
+		SecretID:               core.StringPtr(secretID),
+		SecretVersionPrototype: prototype,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Rotation Failed",
+			fmt.Sprintf("Failed to create a new version of secret '%s': %s\n%s", secretID, err.Error(), response),
+		)
+		return
+	}
+
+	var version smRotatedSecretVersion
+	if err := remarshalSecretsManagerModel(versionIntf, &version); err != nil {
+		resp.Diagnostics.AddError(
+			"Rotation Failed",
+			fmt.Sprintf("Failed to read the new version of secret '%s': %s", secretID, err.Error()),
+		)
+		return
+	}
+
+	if noWait {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Version '%s' of secret '%s' created (no-wait mode)", version.ID, secretID),
+		})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for version '%s' to be active (timeout: %v)...", version.ID, waitTimeout),
+	})
+
+	if err := waitForRotatedSecretVersion(ctx, client, secretID, version.ID, waitTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Rotation Failed",
+			fmt.Sprintf("Version '%s' of secret '%s' did not become active: %s", version.ID, secretID, err.Error()),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Version '%s' of secret '%s' is active", version.ID, secretID),
+	})
+
+	if !expirePrevious {
+		return
+	}
+
+	response, err = client.DeleteSecretVersionDataWithContext(ctx, &secretsmanagerv2.DeleteSecretVersionDataOptions{
+		SecretID: core.StringPtr(secretID),
+		ID:       core.StringPtr(secretsmanagerv2.SecretVersionLocks_VersionAlias_Previous),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Expiring Previous Version Failed",
+			fmt.Sprintf("Secret '%s' was rotated, but the data of its previous version could not be deleted. Locked versions cannot be expired. %s\n%s", secretID, err.Error(), response),
+		)
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Data of the previous version of secret '%s' deleted", secretID),
+	})
+}
+
+// secretVersionPrototypeForRotation builds the version prototype that rotates a secret of the given type
+func secretVersionPrototypeForRotation(secretType string, payload types.String) (secretsmanagerv2.SecretVersionPrototypeIntf, error) {
+	hasPayload := !payload.IsNull() && !payload.IsUnknown()
+
+	switch secretType {
+	case ArbitrarySecretType:
+		if !hasPayload {
+			return nil, fmt.Errorf("payload is required to rotate an arbitrary secret")
+		}
+		return &secretsmanagerv2.ArbitrarySecretVersionPrototype{
+			Payload: core.StringPtr(payload.ValueString()),
+		}, nil
+	case KvSecretType:
+		if !hasPayload {
+			return nil, fmt.Errorf("payload is required to rotate a kv secret")
+		}
+		var data map[string]interface{}
+		if err := json.Unmarshal([]byte(payload.ValueString()), &data); err != nil {
+			return nil, fmt.Errorf("payload of a kv secret must be a JSON object: %s", err)
+		}
+		return &secretsmanagerv2.KVSecretVersionPrototype{
+			Data: data,
+		}, nil
+	case IAMCredentialsSecretType, UsernamePasswordSecretType, PrivateCertSecretType:
+		if hasPayload {
+			return nil, fmt.Errorf("payload is not supported for %s secrets, their new version is generated by Secrets Manager", secretType)
+		}
+		switch secretType {
+		case IAMCredentialsSecretType:
+			return &secretsmanagerv2.IAMCredentialsSecretVersionPrototype{}, nil
+		case UsernamePasswordSecretType:
+			return &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{}, nil
+		default:
+			return &secretsmanagerv2.PrivateCertificateVersionPrototype{}, nil
+		}
+	default:
+		return nil, fmt.Errorf("secrets of type %s cannot be rotated by this action", secretType)
+	}
+}
+
+func getRotatedSecretMetadata(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secretID string) (*smRotatedSecretMetadata, error) {
+	secretIntf, response, err := client.GetSecretMetadataWithContext(ctx, &secretsmanagerv2.GetSecretMetadataOptions{
+		ID: core.StringPtr(secretID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get secret '%s': %s\n%s", secretID, err, response)
+	}
+
+	secret := &smRotatedSecretMetadata{}
+	if err := remarshalSecretsManagerModel(secretIntf, secret); err != nil {
+		return nil, fmt.Errorf("failed to read secret '%s': %s", secretID, err)
+	}
+	return secret, nil
+}
+
+// remarshalSecretsManagerModel copies the common fields of a secret type specific SDK model into target
+func remarshalSecretsManagerModel(model interface{}, target interface{}) error {
+	data, err := json.Marshal(model)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, target)
+}
+
+// waitForRotatedSecretVersion waits until the secret is active and versionID is its current version
+func waitForRotatedSecretVersion(ctx context.Context, client *secretsmanagerv2.SecretsManagerV2, secretID, versionID string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		secret, err := getRotatedSecretMetadata(ctx, client, secretID)
+		if err != nil {
+			return err
+		}
+
+		switch secret.StateDescription {
+		case secretsmanagerv2.SecretMetadata_StateDescription_Active:
+			versionIntf, response, err := client.GetSecretVersionMetadataWithContext(ctx, &secretsmanagerv2.GetSecretVersionMetadataOptions{
+				SecretID: core.StringPtr(secretID),
+				ID:       core.StringPtr(versionID),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get version '%s': %s\n%s", versionID, err, response)
+			}
+			var version smRotatedSecretVersion
+			if err := remarshalSecretsManagerModel(versionIntf, &version); err != nil {
+				return err
+			}
+			if version.Alias == secretsmanagerv2.SecretVersionLocks_VersionAlias_Current {
+				return nil
+			}
+		case secretsmanagerv2.SecretMetadata_StateDescription_PreActivation:
+		default:
+			return fmt.Errorf("secret is in state %s", secret.StateDescription)
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %v", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("operation cancelled: %w", ctx.Err())
+		case <-time.After(secretRotatePollInterval):
+		}
+	}
+}
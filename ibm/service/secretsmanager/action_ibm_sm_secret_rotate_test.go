@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmSecretRotateAction(t *testing.T) {
+	resourceName := "ibm_sm_arbitrary_secret.sm_arbitrary_secret"
+	secretName := fmt.Sprintf("tf-rotated-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acc.TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: acc.TestAccProtoV6ProviderFactories(),
+		CheckDestroy:             testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmSmSecretRotateSecretConfig(secretName),
+			},
+			{
+				Config: testAccCheckIbmSmSecretRotateSecretConfig(secretName) + testAccCheckIbmSmSecretRotateActionConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmSecretRotated(resourceName, "rotated-payload"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretRotateSecretConfig(secretName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+		instance_id = "%s"
+		region      = "%s"
+		name        = "%s"
+		payload     = "initial-payload"
+		lifecycle {
+			ignore_changes = [payload]
+		}
+	}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, secretName)
+}
+
+func testAccCheckIbmSmSecretRotateActionConfig() string {
+	return fmt.Sprintf(`
+	action "ibm_sm_secret_rotate" "rotate" {
+		config {
+			instance_id             = "%s"
+			region                  = "%s"
+			secret_id               = ibm_sm_arbitrary_secret.sm_arbitrary_secret.secret_id
+			payload                 = "rotated-payload"
+			expire_previous_version = true
+		}
+	}
+
+	resource "terraform_data" "trigger_rotation" {
+		input = ibm_sm_arbitrary_secret.sm_arbitrary_secret.secret_id
+		lifecycle {
+			action_trigger {
+				events  = [after_create]
+				actions = [action.ibm_sm_secret_rotate.rotate]
+			}
+		}
+	}
+	`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
+
+func testAccCheckIbmSmSecretRotated(n string, payload string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		arbitrarySecretIntf, err := getSecret(s, n)
+		if err != nil {
+			return err
+		}
+		secret := arbitrarySecretIntf.(*secretsmanagerv2.ArbitrarySecret)
+
+		if err := verifyAttr(*secret.Payload, payload, "secret payload after rotation"); err != nil {
+			return err
+		}
+		if *secret.VersionsTotal != 2 {
+			return fmt.Errorf("Wrong number of versions after rotation: %d", *secret.VersionsTotal)
+		}
+		return nil
+	}
+}
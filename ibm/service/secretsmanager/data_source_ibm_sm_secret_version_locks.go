@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func DataSourceIbmSmSecretVersionLocks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionLocksRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "current",
+				Description: "The ID of the secret version, or the alias `current` or `previous`.",
+			},
+			"locks": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The locks of the secret version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the lock.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "An extended description of the lock.",
+						},
+						"attributes": &schema.Schema{
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Optional information that is associated with the lock.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the lock was created. The date format follows RFC 3339.",
+						},
+						"updated_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the lock was recently modified. The date format follows RFC 3339.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier that is associated with the entity that created the lock.",
+						},
+						"secret_version_id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the locked secret version.",
+						},
+						"secret_version_alias": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The alias of the locked secret version, `current` or `previous`.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionLocksRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+
+	secretId := d.Get("secret_id").(string)
+	versionId := d.Get("version_id").(string)
+
+	locks, err := listSecretVersionLocks(context, secretsManagerClient, secretId, versionId)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretVersionLocksWithContext failed %s", err)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretVersionLocksWithContext failed %s", err), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instanceId, secretId, versionId))
+
+	lockList := make([]map[string]interface{}, 0, len(locks))
+	for _, lock := range locks {
+		lockMap := secretLockToMap(lock)
+		lockMap["updated_at"] = DateTimeToRFC3339(lock.UpdatedAt)
+		lockMap["secret_version_id"] = flex.StringValue(lock.SecretVersionID)
+		lockMap["secret_version_alias"] = flex.StringValue(lock.SecretVersionAlias)
+		lockList = append(lockList, lockMap)
+	}
+
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting region"), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("locks", lockList); err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error setting locks"), fmt.Sprintf("(Data) %s", SecretVersionLocksResourceName), "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
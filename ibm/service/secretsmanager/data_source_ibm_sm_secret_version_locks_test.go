@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretVersionLocksDataSourceBasic(t *testing.T) {
+	secretName := fmt.Sprintf("tf-locked-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionLocksDataSourceConfig(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version_locks.sm_secret_version_locks", "locks.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version_locks.sm_secret_version_locks", "locks.0.name", "lock-a"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_version_locks.sm_secret_version_locks", "locks.0.secret_version_alias", "current"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_version_locks.sm_secret_version_locks", "locks.0.secret_version_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_version_locks.sm_secret_version_locks", "locks.0.created_at"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionLocksDataSourceConfig(secretName string) string {
+	return testAccCheckIbmSmSecretVersionLocksConfig(secretName, `
+					locks {
+						name = "lock-a"
+					}`) + fmt.Sprintf(`
+
+		data "ibm_sm_secret_version_locks" "sm_secret_version_locks" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_secret_version_locks.sm_secret_version_locks.secret_id
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion)
+}
@@ -0,0 +1,452 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmSecretVersionLocks() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretVersionLocksCreate,
+		ReadContext:   resourceIbmSmSecretVersionLocksRead,
+		UpdateContext: resourceIbmSmSecretVersionLocksUpdate,
+		DeleteContext: resourceIbmSmSecretVersionLocksDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          "current",
+				DiffSuppressFunc: suppressSecretVersionAliasDiff,
+				Description:      "The ID of the secret version to lock, or the alias `current` or `previous`. An alias is resolved to the ID of the version when the locks are created.",
+			},
+			"mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					secretsmanagerv2.CreateSecretVersionLocksBulkOptions_Mode_RemovePrevious,
+					secretsmanagerv2.CreateSecretVersionLocksBulkOptions_Mode_RemovePreviousAndDelete,
+				}, false),
+				Description: "An optional exclusive lock mode. `remove_previous` removes locks with matching names from the previous version of the secret. `remove_previous_and_delete` also deletes the data of the previous version if it has no locks left.",
+			},
+			"locks": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The locks to attach to the secret version.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "A human-readable name to assign to the lock. The lock name must be unique per secret version.",
+						},
+						"description": &schema.Schema{
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "An extended description of the lock.",
+						},
+						"attributes": &schema.Schema{
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Optional information to associate with a lock, such as resources CRNs to be used by automation.",
+						},
+						"created_at": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date when the lock was created. The date format follows RFC 3339.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier that is associated with the entity that created the lock.",
+						},
+					},
+				},
+			},
+			"secret_version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the locked secret version.",
+			},
+			"secret_version_alias": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The alias of the locked secret version, `current` or `previous`.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret group of the secret.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmSecretVersionLocksMapToLockPrototypes(locks []interface{}) []secretsmanagerv2.SecretLockPrototype {
+	prototypes := make([]secretsmanagerv2.SecretLockPrototype, 0, len(locks))
+	for _, l := range locks {
+		lock := l.(map[string]interface{})
+		prototype := secretsmanagerv2.SecretLockPrototype{
+			Name: core.StringPtr(lock["name"].(string)),
+		}
+		if description, ok := lock["description"].(string); ok && description != "" {
+			prototype.Description = core.StringPtr(description)
+		}
+		if attributes, ok := lock["attributes"].(map[string]interface{}); ok && len(attributes) > 0 {
+			prototype.Attributes = attributes
+		}
+		prototypes = append(prototypes, prototype)
+	}
+	return prototypes
+}
+
+func resourceIbmSmSecretVersionLocksCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	region := getRegion(secretsManagerClient, d)
+	instanceId := d.Get("instance_id").(string)
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+
+	secretId := d.Get("secret_id").(string)
+	versionId := d.Get("version_id").(string)
+
+	createSecretVersionLocksBulkOptions := &secretsmanagerv2.CreateSecretVersionLocksBulkOptions{}
+	createSecretVersionLocksBulkOptions.SetSecretID(secretId)
+	createSecretVersionLocksBulkOptions.SetID(versionId)
+	createSecretVersionLocksBulkOptions.SetLocks(resourceIbmSmSecretVersionLocksMapToLockPrototypes(d.Get("locks").([]interface{})))
+	if _, ok := d.GetOk("mode"); ok {
+		createSecretVersionLocksBulkOptions.SetMode(d.Get("mode").(string))
+	}
+
+	secretLocks, response, err := secretsManagerClient.CreateSecretVersionLocksBulkWithContext(context, createSecretVersionLocksBulkOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretVersionLocksBulkWithContext failed: %s\n%s", err.Error(), response), SecretVersionLocksResourceName, "create")
+		return tfErr.GetDiag()
+	}
+
+	// Resolve a version alias, so the locks stay attached to the same version after a rotation
+	resolvedVersionId := versionId
+	for _, version := range secretLocks.Versions {
+		if version.VersionID != nil && (*version.VersionID == versionId || (version.VersionAlias != nil && *version.VersionAlias == versionId)) {
+			resolvedVersionId = *version.VersionID
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", region, instanceId, secretId, resolvedVersionId))
+
+	return resourceIbmSmSecretVersionLocksRead(context, d, meta)
+}
+
+func resourceIbmSmSecretVersionLocksParseID(id string) (region, instanceId, secretId, versionId string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 {
+		return "", "", "", "", fmt.Errorf("Wrong format of resource ID. To import secret version locks use the format `<region>/<instance_id>/<secret_id>/<version_id>`")
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
+
+func listSecretVersionLocks(context context.Context, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, secretId string, versionId string) ([]secretsmanagerv2.SecretLock, error) {
+	listSecretVersionLocksOptions := &secretsmanagerv2.ListSecretVersionLocksOptions{}
+	listSecretVersionLocksOptions.SetSecretID(secretId)
+	listSecretVersionLocksOptions.SetID(versionId)
+
+	pager, err := secretsManagerClient.NewSecretVersionLocksPager(listSecretVersionLocksOptions)
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(context)
+}
+
+func secretLockToMap(lock secretsmanagerv2.SecretLock) map[string]interface{} {
+	lockMap := map[string]interface{}{
+		"name":       flex.StringValue(lock.Name),
+		"created_at": DateTimeToRFC3339(lock.CreatedAt),
+		"created_by": flex.StringValue(lock.CreatedBy),
+	}
+	if lock.Description != nil {
+		lockMap["description"] = *lock.Description
+	}
+	if len(lock.Attributes) > 0 {
+		attributes := make(map[string]interface{}, len(lock.Attributes))
+		for k, v := range lock.Attributes {
+			attributes[k] = fmt.Sprint(v)
+		}
+		lockMap["attributes"] = attributes
+	}
+	return lockMap
+}
+
+// suppressSecretVersionAliasDiff treats the alias of the locked version as equal to its ID.
+func suppressSecretVersionAliasDiff(k, old, new string, d *schema.ResourceData) bool {
+	versionID := d.Get("secret_version_id").(string)
+	alias := d.Get("secret_version_alias").(string)
+	if versionID == "" || alias == "" {
+		return false
+	}
+	return (old == versionID && new == alias) || (old == alias && new == versionID)
+}
+
+func resourceIbmSmSecretVersionLocksRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := resourceIbmSmSecretVersionLocksParseID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(nil, err.Error(), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+
+	locks, err := listSecretVersionLocks(context, secretsManagerClient, secretId, versionId)
+	if err != nil {
+		if strings.Contains(err.Error(), "Not Found") || strings.Contains(err.Error(), "not found") {
+			log.Printf("[WARN] Secret version (%s) of secret (%s) not found, removing its locks from state", versionId, secretId)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] ListSecretVersionLocksWithContext failed %s", err)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListSecretVersionLocksWithContext failed %s", err), SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	// Only the locks managed by this resource are read, other applications may lock the same version.
+	// All locks are managed after an import.
+	managed := map[string]bool{}
+	for _, l := range d.Get("locks").([]interface{}) {
+		managed[l.(map[string]interface{})["name"].(string)] = true
+	}
+	found := map[string]secretsmanagerv2.SecretLock{}
+	for _, lock := range locks {
+		if lock.Name != nil && (len(managed) == 0 || managed[*lock.Name]) {
+			found[*lock.Name] = lock
+		}
+	}
+	if len(found) == 0 {
+		log.Printf("[WARN] Locks of secret version (%s) of secret (%s) not found, removing them from state", versionId, secretId)
+		d.SetId("")
+		return nil
+	}
+
+	// Keep the configured order of the locks
+	lockList := make([]map[string]interface{}, 0, len(found))
+	for _, l := range d.Get("locks").([]interface{}) {
+		if lock, ok := found[l.(map[string]interface{})["name"].(string)]; ok {
+			lockList = append(lockList, secretLockToMap(lock))
+		}
+	}
+	if len(managed) == 0 {
+		for _, lock := range locks {
+			lockList = append(lockList, secretLockToMap(lock))
+		}
+	}
+	var anyLock secretsmanagerv2.SecretLock
+	for _, lock := range found {
+		anyLock = lock
+		break
+	}
+
+	if err = d.Set("instance_id", instanceId); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting instance_id", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("region", region); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting region", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_id", secretId); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting secret_id", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if _, ok := d.GetOk("version_id"); !ok {
+		// After an import, record the alias of the version, which matches the default of version_id
+		if anyLock.SecretVersionAlias != nil && *anyLock.SecretVersionAlias != "" {
+			d.Set("version_id", *anyLock.SecretVersionAlias)
+		} else {
+			d.Set("version_id", versionId)
+		}
+	}
+	if err = d.Set("locks", lockList); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting locks", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_version_id", versionId); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting secret_version_id", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_version_alias", anyLock.SecretVersionAlias); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting secret_version_alias", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+	if err = d.Set("secret_group_id", anyLock.SecretGroupID); err != nil {
+		tfErr := flex.TerraformErrorf(err, "Error setting secret_group_id", SecretVersionLocksResourceName, "read")
+		return tfErr.GetDiag()
+	}
+
+	return nil
+}
+
+func resourceIbmSmSecretVersionLocksUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "update")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := resourceIbmSmSecretVersionLocksParseID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(nil, err.Error(), SecretVersionLocksResourceName, "update")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+
+	if d.HasChange("locks") {
+		oldLocks, newLocks := d.GetChange("locks")
+
+		oldByName := map[string]map[string]interface{}{}
+		for _, l := range oldLocks.([]interface{}) {
+			lock := l.(map[string]interface{})
+			oldByName[lock["name"].(string)] = lock
+		}
+
+		// Locks cannot be modified, so changed locks are removed and created again
+		var removeNames []string
+		var createLocks []interface{}
+		newNames := map[string]bool{}
+		for _, l := range newLocks.([]interface{}) {
+			lock := l.(map[string]interface{})
+			name := lock["name"].(string)
+			newNames[name] = true
+			oldLock, ok := oldByName[name]
+			if !ok {
+				createLocks = append(createLocks, lock)
+			} else if !secretLocksEqual(oldLock, lock) {
+				removeNames = append(removeNames, name)
+				createLocks = append(createLocks, lock)
+			}
+		}
+		for name := range oldByName {
+			if !newNames[name] {
+				removeNames = append(removeNames, name)
+			}
+		}
+
+		if len(removeNames) > 0 {
+			deleteSecretVersionLocksBulkOptions := &secretsmanagerv2.DeleteSecretVersionLocksBulkOptions{}
+			deleteSecretVersionLocksBulkOptions.SetSecretID(secretId)
+			deleteSecretVersionLocksBulkOptions.SetID(versionId)
+			deleteSecretVersionLocksBulkOptions.SetName(removeNames)
+
+			_, response, err := secretsManagerClient.DeleteSecretVersionLocksBulkWithContext(context, deleteSecretVersionLocksBulkOptions)
+			if err != nil {
+				log.Printf("[DEBUG] DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response), SecretVersionLocksResourceName, "update")
+				return tfErr.GetDiag()
+			}
+		}
+
+		if len(createLocks) > 0 {
+			createSecretVersionLocksBulkOptions := &secretsmanagerv2.CreateSecretVersionLocksBulkOptions{}
+			createSecretVersionLocksBulkOptions.SetSecretID(secretId)
+			createSecretVersionLocksBulkOptions.SetID(versionId)
+			createSecretVersionLocksBulkOptions.SetLocks(resourceIbmSmSecretVersionLocksMapToLockPrototypes(createLocks))
+			if _, ok := d.GetOk("mode"); ok {
+				createSecretVersionLocksBulkOptions.SetMode(d.Get("mode").(string))
+			}
+
+			_, response, err := secretsManagerClient.CreateSecretVersionLocksBulkWithContext(context, createSecretVersionLocksBulkOptions)
+			if err != nil {
+				log.Printf("[DEBUG] CreateSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateSecretVersionLocksBulkWithContext failed %s\n%s", err, response), SecretVersionLocksResourceName, "update")
+				return tfErr.GetDiag()
+			}
+		}
+	}
+
+	return resourceIbmSmSecretVersionLocksRead(context, d, meta)
+}
+
+func secretLocksEqual(oldLock, newLock map[string]interface{}) bool {
+	if oldLock["description"] != newLock["description"] {
+		return false
+	}
+	oldAttributes, _ := oldLock["attributes"].(map[string]interface{})
+	newAttributes, _ := newLock["attributes"].(map[string]interface{})
+	if len(oldAttributes) != len(newAttributes) {
+		return false
+	}
+	for k, v := range newAttributes {
+		if oldAttributes[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceIbmSmSecretVersionLocksDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(meta.(conns.ClientSession))
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, "", SecretVersionLocksResourceName, "delete")
+		return tfErr.GetDiag()
+	}
+
+	region, instanceId, secretId, versionId, err := resourceIbmSmSecretVersionLocksParseID(d.Id())
+	if err != nil {
+		tfErr := flex.TerraformErrorf(nil, err.Error(), SecretVersionLocksResourceName, "delete")
+		return tfErr.GetDiag()
+	}
+	secretsManagerClient = getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, getEndpointType(secretsManagerClient, d), endpointsFile)
+
+	var names []string
+	for _, l := range d.Get("locks").([]interface{}) {
+		names = append(names, l.(map[string]interface{})["name"].(string))
+	}
+
+	deleteSecretVersionLocksBulkOptions := &secretsmanagerv2.DeleteSecretVersionLocksBulkOptions{}
+	deleteSecretVersionLocksBulkOptions.SetSecretID(secretId)
+	deleteSecretVersionLocksBulkOptions.SetID(versionId)
+	deleteSecretVersionLocksBulkOptions.SetName(names)
+
+	_, response, err := secretsManagerClient.DeleteSecretVersionLocksBulkWithContext(context, deleteSecretVersionLocksBulkOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response)
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("DeleteSecretVersionLocksBulkWithContext failed %s\n%s", err, response), SecretVersionLocksResourceName, "delete")
+		return tfErr.GetDiag()
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmSecretVersionLocksBasic(t *testing.T) {
+	resourceName := "ibm_sm_secret_version_locks.sm_secret_version_locks"
+	secretName := fmt.Sprintf("tf-locked-secret-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmSecretVersionLocksDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionLocksConfig(secretName, `
+					locks {
+						name        = "lock-a"
+						description = "Used by application A"
+						attributes  = { crn = "crn:v1:bluemix:public:app-a" }
+					}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmSecretVersionLocksExists(resourceName, []string{"lock-a"}),
+					resource.TestCheckResourceAttr(resourceName, "version_id", "current"),
+					resource.TestCheckResourceAttr(resourceName, "secret_version_alias", "current"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_version_id"),
+					resource.TestCheckResourceAttr(resourceName, "locks.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "locks.0.name", "lock-a"),
+					resource.TestCheckResourceAttr(resourceName, "locks.0.attributes.crn", "crn:v1:bluemix:public:app-a"),
+					resource.TestCheckResourceAttrSet(resourceName, "locks.0.created_at"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionLocksConfig(secretName, `
+					locks {
+						name        = "lock-a"
+						description = "Used by application A and B"
+					}
+					locks {
+						name = "lock-b"
+					}
+					mode = "remove_previous"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmSecretVersionLocksExists(resourceName, []string{"lock-a", "lock-b"}),
+					resource.TestCheckResourceAttr(resourceName, "locks.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "locks.0.description", "Used by application A and B"),
+					resource.TestCheckResourceAttr(resourceName, "locks.1.name", "lock-b"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_id", "mode"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionLocksConfig(secretName string, locks string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			instance_id = "%s"
+			region      = "%s"
+			name        = "%s"
+			payload     = "secret-credentials"
+		}
+
+		resource "ibm_sm_secret_version_locks" "sm_secret_version_locks" {
+			instance_id = "%s"
+			region      = "%s"
+			secret_id   = ibm_sm_arbitrary_secret.sm_arbitrary_secret.secret_id
+			%s
+		}`, acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, secretName,
+		acc.SecretsManagerInstanceID, acc.SecretsManagerInstanceRegion, locks)
+}
+
+func listSecretVersionLockNames(id string) ([]string, error) {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return nil, err
+	}
+	secretsManagerClient = getClientWithInstanceEndpointTest(secretsManagerClient)
+
+	parts := strings.Split(id, "/")
+	listSecretVersionLocksOptions := &secretsmanagerv2.ListSecretVersionLocksOptions{}
+	listSecretVersionLocksOptions.SetSecretID(parts[2])
+	listSecretVersionLocksOptions.SetID(parts[3])
+
+	locks, _, err := secretsManagerClient.ListSecretVersionLocks(listSecretVersionLocksOptions)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, lock := range locks.Locks {
+		names = append(names, *lock.Name)
+	}
+	return names, nil
+}
+
+func testAccCheckIbmSmSecretVersionLocksExists(n string, expected []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		names, err := listSecretVersionLockNames(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		for _, name := range expected {
+			found := false
+			for _, lockName := range names {
+				if lockName == name {
+					found = true
+				}
+			}
+			if !found {
+				return fmt.Errorf("Lock %s not found on secret version, found %v", name, names)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckIbmSmSecretVersionLocksDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_secret_version_locks" {
+			continue
+		}
+
+		// The locks are gone, either with the secret or removed by the resource
+		names, err := listSecretVersionLockNames(rs.Primary.ID)
+		if err == nil && len(names) > 0 {
+			return fmt.Errorf("Secret version locks still exist: %v", names)
+		}
+	}
+
+	return nil
+}
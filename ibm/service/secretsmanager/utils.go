@@ -53,9 +53,10 @@ const (
 	PublicCertConfigDnsClassicInfrastructureResourceName = "ibm_sm_public_certificate_configuration_dns_classic_infrastructure"
 	PublicCertConfigActionValidateManualDNSResourceName  = "ibm_sm_public_certificate_action_validate_manual_dns"
 
-	SecretGroupResourceName  = "ibm_sm_secret_group"
-	SecretGroupsResourceName = "ibm_sm_secret_groups"
-	SecretsResourceName      = "ibm_sm_secrets"
+	SecretGroupResourceName        = "ibm_sm_secret_group"
+	SecretGroupsResourceName       = "ibm_sm_secret_groups"
+	SecretsResourceName            = "ibm_sm_secrets"
+	SecretVersionLocksResourceName = "ibm_sm_secret_version_locks"
 )
 
 func getRegion(originalClient *secretsmanagerv2.SecretsManagerV2, d *schema.ResourceData) string {
@@ -132,6 +133,42 @@ func getClientWithInstanceEndpoint(originalClient *secretsmanagerv2.SecretsManag
 	return newClient
 }
 
+// GetClientForSecretCRN returns a client for the Secrets Manager instance of the given secret CRN
+// (crn:v1:<cname>:<ctype>:secrets-manager:<region>:a/<account>:<instance>:secret:<secret>) and the secret ID.
+func GetClientForSecretCRN(clientSession conns.ClientSession, secretCRN string) (*secretsmanagerv2.SecretsManagerV2, string, error) {
+	parts := strings.Split(secretCRN, ":")
+	if len(parts) != 10 || parts[4] != "secrets-manager" || parts[8] != "secret" || parts[9] == "" {
+		return nil, "", fmt.Errorf("%s is not the CRN of a Secrets Manager secret", secretCRN)
+	}
+	region, instanceId, secretId := parts[5], parts[7], parts[9]
+
+	secretsManagerClient, err := GetClientForInstance(clientSession, instanceId, region, "")
+	if err != nil {
+		return nil, "", err
+	}
+	return secretsManagerClient, secretId, nil
+}
+
+// GetClientForInstance returns a client for the given Secrets Manager instance. The region and endpoint
+// type default to those of the provider's configuration when empty.
+func GetClientForInstance(clientSession conns.ClientSession, instanceId string, region string, endpointType string) (*secretsmanagerv2.SecretsManagerV2, error) {
+	secretsManagerClient, endpointsFile, err := getSecretsManagerSession(clientSession)
+	if err != nil {
+		return nil, err
+	}
+	baseUrl := secretsManagerClient.Service.GetServiceURL()
+	if region == "" {
+		region = strings.Split(strings.Replace(baseUrl, "private.", "", 1), ".")[1]
+	}
+	if endpointType == "" {
+		endpointType = "public"
+		if strings.Contains(baseUrl, "private.") {
+			endpointType = "private"
+		}
+	}
+	return getClientWithInstanceEndpoint(secretsManagerClient, instanceId, region, endpointType, endpointsFile), nil
+}
+
 // Add the fields needed for building the instance endpoint to the given schema
 func AddInstanceFields(resource *schema.Resource) *schema.Resource {
 	resource.Schema["instance_id"] = &schema.Schema{
@@ -20,6 +20,7 @@ func DataSourceIBMISSubnet() *schema.Resource {
 		ReadContext: dataSourceIBMISSubnetRead,
 
 		Schema: map[string]*schema.Schema{
+			isRegionalRegion: dataSourceIBMISRegionSchema(),
 
 			"identifier": {
 				Type:         schema.TypeString,
@@ -219,7 +220,7 @@ func dataSourceIBMISSubnetRead(context context.Context, d *schema.ResourceData,
 }
 
 func subnetGetByNameOrID(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_subnet", "read", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -286,6 +287,9 @@ func subnetGetByNameOrID(context context.Context, d *schema.ResourceData, meta i
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting available_ipv4_address_count: %s", err), "(Data) ibm_is_subnet", "read", "set-routing_table").GetDiag()
 		}
 	}
+	if err = setRegionalRegion(d, meta); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting region: %s", err), "(Data) ibm_is_subnet", "read", "set-region").GetDiag()
+	}
 	if err = d.Set("name", subnet.Name); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting name: %s", err), "(Data) ibm_is_subnet", "read", "set-name").GetDiag()
 	}
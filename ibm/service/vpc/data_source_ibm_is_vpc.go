@@ -22,6 +22,8 @@ func DataSourceIBMISVPC() *schema.Resource {
 		ReadContext: dataSourceIBMISVPCRead,
 
 		Schema: map[string]*schema.Schema{
+			isRegionalRegion: dataSourceIBMISRegionSchema(),
+
 			isVPCDefaultNetworkACL: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -573,7 +575,7 @@ func dataSourceIBMISVPCRead(context context.Context, d *schema.ResourceData, met
 }
 
 func vpcGetByNameOrId(context context.Context, d *schema.ResourceData, meta interface{}, name, id string) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpc", "read", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -633,6 +635,9 @@ func setVpcDetails(context context.Context, d *schema.ResourceData, vpc *vpcv1.V
 		var err error
 		d.SetId(*vpc.ID)
 		d.Set("identifier", *vpc.ID)
+		if err = setRegionalRegion(d, meta); err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting region: %s", err), "(Data) ibm_is_vpc", "read", "set-region").GetDiag()
+		}
 		if err = d.Set("name", vpc.Name); err != nil {
 			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting name: %s", err), "(Data) ibm_is_vpc", "read", "set-name").GetDiag()
 		}
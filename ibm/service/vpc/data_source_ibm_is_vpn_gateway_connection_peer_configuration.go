@@ -0,0 +1,837 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+)
+
+const (
+	vpnPeerDeviceStrongSwan = "strongswan"
+	vpnPeerDeviceLibreSwan  = "libreswan"
+	vpnPeerDeviceCiscoASA   = "cisco_asa"
+	vpnPeerDeviceJuniperSRX = "juniper_srx"
+	vpnPeerDevicePfSense    = "pfsense"
+)
+
+// Values used by the VPN gateway when a connection has no IKE or IPsec policy
+// attached and auto-negotiation is in effect. The peer must propose a
+// combination that the gateway accepts, so the strongest defaults are rendered.
+const (
+	vpnPeerDefaultIkeVersion      = 2
+	vpnPeerDefaultIkeEncryption   = "aes256"
+	vpnPeerDefaultIkeAuth         = "sha256"
+	vpnPeerDefaultIkeDhGroup      = 14
+	vpnPeerDefaultIkeKeyLifetime  = 28800
+	vpnPeerDefaultIPsecEncryption = "aes256"
+	vpnPeerDefaultIPsecAuth       = "sha256"
+	vpnPeerDefaultIPsecPfs        = "group_14"
+	vpnPeerDefaultIPsecLifetime   = 3600
+)
+
+func DataSourceIBMIsVPNGatewayConnectionPeerConfiguration() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMIsVPNGatewayConnectionPeerConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			"vpn_gateway": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway identifier.",
+			},
+			"vpn_gateway_connection": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The VPN gateway connection identifier.",
+			},
+			"device_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{vpnPeerDeviceStrongSwan, vpnPeerDeviceLibreSwan, vpnPeerDeviceCiscoASA, vpnPeerDeviceJuniperSRX, vpnPeerDevicePfSense}),
+				Description:  "The type of the peer device to render the configuration for. Allowed values are `strongswan`, `libreswan`, `cisco_asa`, `juniper_srx` and `pfsense`.",
+			},
+			"peer_interface": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "outside",
+				Description: "The name of the peer device interface that terminates the tunnels, used by the `cisco_asa`, `juniper_srx` and `pfsense` templates.",
+			},
+			"file_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The file path to store the rendered peer configuration.",
+			},
+			"mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The mode of the VPN gateway connection, `policy` or `route`.",
+			},
+			"vpn_gateway_public_ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The public IP addresses of the VPN gateway members, one tunnel is rendered for each.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"local_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The local CIDRs of the VPN gateway connection, the remote networks from the peer's point of view.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"peer_cidrs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The peer CIDRs of the VPN gateway connection, the local networks from the peer's point of view.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"ike_proposal": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IKE (phase 1) parameters used in the rendered configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ike_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The IKE protocol version.",
+						},
+						"encryption_algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The encryption algorithm.",
+						},
+						"authentication_algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The authentication algorithm.",
+						},
+						"dh_group": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The Diffie-Hellman group.",
+						},
+						"key_lifetime": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The key lifetime in seconds.",
+						},
+					},
+				},
+			},
+			"ipsec_proposal": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IPsec (phase 2) parameters used in the rendered configuration.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"encryption_algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The encryption algorithm.",
+						},
+						"authentication_algorithm": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The authentication algorithm.",
+						},
+						"pfs": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Perfect Forward Secrecy group.",
+						},
+						"key_lifetime": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The key lifetime in seconds.",
+						},
+					},
+				},
+			},
+			"peer_configuration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The rendered peer device configuration. It contains the preshared key of the connection.",
+			},
+		},
+	}
+}
+
+// VPNPeerConfiguration holds the values a peer device template is rendered from.
+type VPNPeerConfiguration struct {
+	ConnectionName   string
+	Mode             string
+	PeerInterface    string
+	PeerAddress      string
+	Psk              string
+	GatewayPublicIPs []string
+	LocalCIDRs       []string
+	PeerCIDRs        []string
+	Tunnels          []VPNPeerTunnel
+
+	IkeVersion        int64
+	IkeEncryption     string
+	IkeAuthentication string
+	IkeDhGroup        int64
+	IkeKeyLifetime    int64
+
+	IPsecEncryption     string
+	IPsecAuthentication string
+	IPsecPfs            string
+	IPsecKeyLifetime    int64
+
+	DpdInterval int64
+	DpdTimeout  int64
+}
+
+// VPNPeerTunnel describes the tunnel interface addressing of a route mode connection.
+type VPNPeerTunnel struct {
+	PublicIP          string
+	TunnelInterfaceIP string
+	NeighborIP        string
+}
+
+// RouteMode reports whether the connection is route based.
+func (c VPNPeerConfiguration) RouteMode() bool {
+	return c.Mode == "route"
+}
+
+func dataSourceIBMIsVPNGatewayConnectionPeerConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	vpnGatewayID := d.Get("vpn_gateway").(string)
+	vpnGatewayConnectionID := d.Get("vpn_gateway_connection").(string)
+
+	getVPNGatewayOptions := &vpcv1.GetVPNGatewayOptions{}
+	getVPNGatewayOptions.SetID(vpnGatewayID)
+	vpnGatewayIntf, _, err := vpcClient.GetVPNGatewayWithContext(context, getVPNGatewayOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVPNGatewayWithContext failed: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if vpnGatewayIntf == nil {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("VPN gateway (%s) not found", vpnGatewayID), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	vpnGateway := vpnGatewayIntf.(*vpcv1.VPNGateway)
+
+	getVPNGatewayConnectionOptions := &vpcv1.GetVPNGatewayConnectionOptions{}
+	getVPNGatewayConnectionOptions.SetVPNGatewayID(vpnGatewayID)
+	getVPNGatewayConnectionOptions.SetID(vpnGatewayConnectionID)
+	vpnGatewayConnectionIntf, _, err := vpcClient.GetVPNGatewayConnectionWithContext(context, getVPNGatewayConnectionOptions)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetVPNGatewayConnectionWithContext failed: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if vpnGatewayConnectionIntf == nil {
+		tfErr := flex.TerraformErrorf(nil, fmt.Sprintf("VPN gateway connection (%s) of VPN gateway (%s) not found", vpnGatewayConnectionID, vpnGatewayID), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	peerConfig, ikePolicyRef, ipsecPolicyRef, err := vpnPeerConfigurationFromConnection(vpnGatewayConnectionIntf)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, err.Error(), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	peerConfig.PeerInterface = d.Get("peer_interface").(string)
+
+	for _, member := range vpnGateway.Members {
+		if member.PublicIP != nil && member.PublicIP.Address != nil {
+			peerConfig.GatewayPublicIPs = append(peerConfig.GatewayPublicIPs, *member.PublicIP.Address)
+		}
+	}
+
+	if !peerConfig.RouteMode() {
+		listLocalCIDRsOptions := &vpcv1.ListVPNGatewayConnectionsLocalCIDRsOptions{}
+		listLocalCIDRsOptions.SetVPNGatewayID(vpnGatewayID)
+		listLocalCIDRsOptions.SetID(vpnGatewayConnectionID)
+		localCIDRs, _, err := vpcClient.ListVPNGatewayConnectionsLocalCIDRsWithContext(context, listLocalCIDRsOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPNGatewayConnectionsLocalCIDRsWithContext failed: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		peerConfig.LocalCIDRs = localCIDRs.CIDRs
+
+		listPeerCIDRsOptions := &vpcv1.ListVPNGatewayConnectionsPeerCIDRsOptions{}
+		listPeerCIDRsOptions.SetVPNGatewayID(vpnGatewayID)
+		listPeerCIDRsOptions.SetID(vpnGatewayConnectionID)
+		peerCIDRs, _, err := vpcClient.ListVPNGatewayConnectionsPeerCIDRsWithContext(context, listPeerCIDRsOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPNGatewayConnectionsPeerCIDRsWithContext failed: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		peerConfig.PeerCIDRs = peerCIDRs.CIDRs
+	}
+
+	if ikePolicyRef != nil && ikePolicyRef.ID != nil {
+		getIkePolicyOptions := &vpcv1.GetIkePolicyOptions{}
+		getIkePolicyOptions.SetID(*ikePolicyRef.ID)
+		ikePolicy, _, err := vpcClient.GetIkePolicyWithContext(context, getIkePolicyOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetIkePolicyWithContext failed: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		peerConfig.IkeVersion = *ikePolicy.IkeVersion
+		peerConfig.IkeEncryption = *ikePolicy.EncryptionAlgorithm
+		peerConfig.IkeAuthentication = *ikePolicy.AuthenticationAlgorithm
+		peerConfig.IkeDhGroup = *ikePolicy.DhGroup
+		peerConfig.IkeKeyLifetime = *ikePolicy.KeyLifetime
+	}
+
+	if ipsecPolicyRef != nil && ipsecPolicyRef.ID != nil {
+		getIpsecPolicyOptions := &vpcv1.GetIpsecPolicyOptions{}
+		getIpsecPolicyOptions.SetID(*ipsecPolicyRef.ID)
+		ipsecPolicy, _, err := vpcClient.GetIpsecPolicyWithContext(context, getIpsecPolicyOptions)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetIpsecPolicyWithContext failed: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+		peerConfig.IPsecEncryption = *ipsecPolicy.EncryptionAlgorithm
+		peerConfig.IPsecAuthentication = *ipsecPolicy.AuthenticationAlgorithm
+		peerConfig.IPsecPfs = *ipsecPolicy.Pfs
+		peerConfig.IPsecKeyLifetime = *ipsecPolicy.KeyLifetime
+	}
+
+	deviceType := d.Get("device_type").(string)
+	rendered, err := RenderVPNPeerConfiguration(deviceType, peerConfig)
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("Error rendering %s peer configuration: %s", deviceType, err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if v, ok := d.GetOk("file_path"); ok {
+		fileName := v.(string)
+		if err = os.WriteFile(fileName, []byte(rendered), 0600); err != nil {
+			return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error saving peer configuration: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "write-file").GetDiag()
+		}
+		log.Printf("[INFO] VPN peer configuration was saved to %s", fileName)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", vpnGatewayID, vpnGatewayConnectionID, deviceType))
+	if err = d.Set("mode", peerConfig.Mode); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting mode: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "set-mode").GetDiag()
+	}
+	if err = d.Set("vpn_gateway_public_ips", peerConfig.GatewayPublicIPs); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting vpn_gateway_public_ips: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "set-vpn_gateway_public_ips").GetDiag()
+	}
+	if err = d.Set("local_cidrs", peerConfig.LocalCIDRs); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting local_cidrs: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "set-local_cidrs").GetDiag()
+	}
+	if err = d.Set("peer_cidrs", peerConfig.PeerCIDRs); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting peer_cidrs: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "set-peer_cidrs").GetDiag()
+	}
+	ikeProposal := map[string]interface{}{
+		"ike_version":              peerConfig.IkeVersion,
+		"encryption_algorithm":     peerConfig.IkeEncryption,
+		"authentication_algorithm": peerConfig.IkeAuthentication,
+		"dh_group":                 peerConfig.IkeDhGroup,
+		"key_lifetime":             peerConfig.IkeKeyLifetime,
+	}
+	if err = d.Set("ike_proposal", []map[string]interface{}{ikeProposal}); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting ike_proposal: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "set-ike_proposal").GetDiag()
+	}
+	ipsecProposal := map[string]interface{}{
+		"encryption_algorithm":     peerConfig.IPsecEncryption,
+		"authentication_algorithm": peerConfig.IPsecAuthentication,
+		"pfs":                      peerConfig.IPsecPfs,
+		"key_lifetime":             peerConfig.IPsecKeyLifetime,
+	}
+	if err = d.Set("ipsec_proposal", []map[string]interface{}{ipsecProposal}); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting ipsec_proposal: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "set-ipsec_proposal").GetDiag()
+	}
+	if err = d.Set("peer_configuration", rendered); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting peer_configuration: %s", err), "(Data) ibm_is_vpn_gateway_connection_peer_configuration", "read", "set-peer_configuration").GetDiag()
+	}
+	return nil
+}
+
+// vpnPeerConfigurationFromConnection collects the connection level values of a
+// VPN gateway connection of any mode, seeded with the auto-negotiation defaults.
+func vpnPeerConfigurationFromConnection(vpnGatewayConnectionIntf vpcv1.VPNGatewayConnectionIntf) (VPNPeerConfiguration, *vpcv1.IkePolicyReference, *vpcv1.IPsecPolicyReference, error) {
+	peerConfig := VPNPeerConfiguration{
+		IkeVersion:          vpnPeerDefaultIkeVersion,
+		IkeEncryption:       vpnPeerDefaultIkeEncryption,
+		IkeAuthentication:   vpnPeerDefaultIkeAuth,
+		IkeDhGroup:          vpnPeerDefaultIkeDhGroup,
+		IkeKeyLifetime:      vpnPeerDefaultIkeKeyLifetime,
+		IPsecEncryption:     vpnPeerDefaultIPsecEncryption,
+		IPsecAuthentication: vpnPeerDefaultIPsecAuth,
+		IPsecPfs:            vpnPeerDefaultIPsecPfs,
+		IPsecKeyLifetime:    vpnPeerDefaultIPsecLifetime,
+	}
+	var name, mode, psk *string
+	var dpd *vpcv1.VPNGatewayConnectionDpd
+	var ikePolicy *vpcv1.IkePolicyReference
+	var ipsecPolicy *vpcv1.IPsecPolicyReference
+	var peerIntf interface{}
+
+	switch connection := vpnGatewayConnectionIntf.(type) {
+	case *vpcv1.VPNGatewayConnection:
+		name, mode, psk, dpd, ikePolicy, ipsecPolicy, peerIntf = connection.Name, connection.Mode, connection.Psk, connection.DeadPeerDetection, connection.IkePolicy, connection.IpsecPolicy, connection.Peer
+		peerConfig.Tunnels = vpnPeerStaticTunnels(connection.Tunnels)
+	case *vpcv1.VPNGatewayConnectionRouteMode:
+		name, mode, psk, dpd, ikePolicy, ipsecPolicy, peerIntf = connection.Name, connection.Mode, connection.Psk, connection.DeadPeerDetection, connection.IkePolicy, connection.IpsecPolicy, connection.Peer
+		peerConfig.Tunnels = vpnPeerStaticTunnels(connection.Tunnels)
+	case *vpcv1.VPNGatewayConnectionRouteModeVPNGatewayConnectionStaticRouteMode:
+		name, mode, psk, dpd, ikePolicy, ipsecPolicy, peerIntf = connection.Name, connection.Mode, connection.Psk, connection.DeadPeerDetection, connection.IkePolicy, connection.IpsecPolicy, connection.Peer
+		peerConfig.Tunnels = vpnPeerStaticTunnels(connection.Tunnels)
+	case *vpcv1.VPNGatewayConnectionRouteModeVPNGatewayConnectionDynamicRouteMode:
+		name, mode, psk, dpd, ikePolicy, ipsecPolicy, peerIntf = connection.Name, connection.Mode, connection.Psk, connection.DeadPeerDetection, connection.IkePolicy, connection.IpsecPolicy, connection.Peer
+		for _, tunnel := range connection.Tunnels {
+			t := VPNPeerTunnel{}
+			if tunnel.PublicIP != nil {
+				t.PublicIP = flex.StringValue(tunnel.PublicIP.Address)
+			}
+			if tunnel.TunnelInterfaceIP != nil {
+				t.TunnelInterfaceIP = flex.StringValue(tunnel.TunnelInterfaceIP.Address)
+			}
+			if tunnel.NeighborIP != nil {
+				t.NeighborIP = flex.StringValue(tunnel.NeighborIP.Address)
+			}
+			peerConfig.Tunnels = append(peerConfig.Tunnels, t)
+		}
+	case *vpcv1.VPNGatewayConnectionPolicyMode:
+		name, mode, psk, dpd, ikePolicy, ipsecPolicy, peerIntf = connection.Name, connection.Mode, connection.Psk, connection.DeadPeerDetection, connection.IkePolicy, connection.IpsecPolicy, connection.Peer
+	default:
+		return peerConfig, nil, nil, fmt.Errorf("unsupported VPN gateway connection type %T", vpnGatewayConnectionIntf)
+	}
+
+	peerConfig.ConnectionName = flex.StringValue(name)
+	peerConfig.Mode = flex.StringValue(mode)
+	peerConfig.Psk = flex.StringValue(psk)
+	if dpd != nil {
+		peerConfig.DpdInterval = int64(flex.IntValue(dpd.Interval))
+		peerConfig.DpdTimeout = int64(flex.IntValue(dpd.Timeout))
+	}
+	peerConfig.PeerAddress = vpnPeerAddress(peerIntf)
+	return peerConfig, ikePolicy, ipsecPolicy, nil
+}
+
+func vpnPeerStaticTunnels(tunnels []vpcv1.VPNGatewayConnectionStaticRouteModeTunnel) (peerTunnels []VPNPeerTunnel) {
+	for _, tunnel := range tunnels {
+		if tunnel.PublicIP != nil {
+			peerTunnels = append(peerTunnels, VPNPeerTunnel{PublicIP: flex.StringValue(tunnel.PublicIP.Address)})
+		}
+	}
+	return peerTunnels
+}
+
+func vpnPeerAddress(peerIntf interface{}) string {
+	var address, fqdn *string
+	switch peer := peerIntf.(type) {
+	case *vpcv1.VPNGatewayConnectionStaticRouteModePeer:
+		address, fqdn = peer.Address, peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionDynamicRouteModePeer:
+		address, fqdn = peer.Address, peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionPolicyModePeer:
+		address, fqdn = peer.Address, peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByAddress:
+		address = peer.Address
+	case *vpcv1.VPNGatewayConnectionDynamicRouteModePeerVPNGatewayConnectionPeerByFqdn:
+		fqdn = peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByAddress:
+		address = peer.Address
+	case *vpcv1.VPNGatewayConnectionStaticRouteModePeerVPNGatewayConnectionPeerByFqdn:
+		fqdn = peer.Fqdn
+	case *vpcv1.VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByAddress:
+		address = peer.Address
+	case *vpcv1.VPNGatewayConnectionPolicyModePeerVPNGatewayConnectionPeerByFqdn:
+		fqdn = peer.Fqdn
+	}
+	if address != nil {
+		return *address
+	}
+	return flex.StringValue(fqdn)
+}
+
+// RenderVPNPeerConfiguration renders the peer side configuration of a VPN
+// gateway connection for the given device type from the built-in templates.
+func RenderVPNPeerConfiguration(deviceType string, peerConfig VPNPeerConfiguration) (string, error) {
+	text, ok := vpnPeerConfigurationTemplates[deviceType]
+	if !ok {
+		return "", fmt.Errorf("unsupported device type %q", deviceType)
+	}
+	if len(peerConfig.GatewayPublicIPs) == 0 {
+		return "", fmt.Errorf("the VPN gateway has no public IP addresses yet")
+	}
+	tmpl, err := template.New(deviceType).Funcs(vpnPeerTemplateFuncs).Parse(text)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, peerConfig); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+var vpnPeerDhGroups = map[int64]string{
+	2:  "modp1024",
+	5:  "modp1536",
+	14: "modp2048",
+	15: "modp3072",
+	16: "modp4096",
+	17: "modp6144",
+	18: "modp8192",
+	19: "ecp256",
+	20: "ecp384",
+	21: "ecp521",
+	22: "modp1024s160",
+	23: "modp2048s224",
+	24: "modp2048s256",
+	31: "curve25519",
+}
+
+// vpnPeerPfsGroup converts an IPsec policy pfs value such as `group_14` into
+// its DH group number, 0 when PFS is disabled.
+func vpnPeerPfsGroup(pfs string) int64 {
+	var group int64
+	if _, err := fmt.Sscanf(pfs, "group_%d", &group); err != nil {
+		return 0
+	}
+	return group
+}
+
+func vpnPeerIsGcm(encryption string) bool {
+	return strings.HasSuffix(encryption, "gcm16")
+}
+
+// vpnPeerKeySize returns the key size of an encryption algorithm such as
+// `aes256` or `aes128gcm16`.
+func vpnPeerKeySize(encryption string) string {
+	size := strings.TrimPrefix(encryption, "aes")
+	return strings.TrimSuffix(size, "gcm16")
+}
+
+var vpnPeerTemplateFuncs = template.FuncMap{
+	"join": strings.Join,
+	"inc":  func(i int) int { return i + 1 },
+	"dh": func(group int64) string {
+		return vpnPeerDhGroups[group]
+	},
+	"pfsGroup": vpnPeerPfsGroup,
+	"isGcm":    vpnPeerIsGcm,
+	"keySize":  vpnPeerKeySize,
+	// strongSwan proposal, for example aes256-sha256-modp2048.
+	"swanIke": func(c VPNPeerConfiguration) string {
+		return fmt.Sprintf("%s-%s-%s", c.IkeEncryption, c.IkeAuthentication, vpnPeerDhGroups[c.IkeDhGroup])
+	},
+	"swanEsp": func(c VPNPeerConfiguration) string {
+		proposal := c.IPsecEncryption
+		if !vpnPeerIsGcm(c.IPsecEncryption) && c.IPsecAuthentication != "disabled" {
+			proposal += "-" + c.IPsecAuthentication
+		}
+		if group := vpnPeerPfsGroup(c.IPsecPfs); group != 0 {
+			proposal += "-" + vpnPeerDhGroups[group]
+		}
+		return proposal
+	},
+	// libreswan spells the SHA2 family as sha2_256, sha2_384 and sha2_512.
+	"libreIke": func(c VPNPeerConfiguration) string {
+		return fmt.Sprintf("%s-%s-%s", c.IkeEncryption, strings.Replace(c.IkeAuthentication, "sha", "sha2_", 1), vpnPeerDhGroups[c.IkeDhGroup])
+	},
+	"libreEsp": func(c VPNPeerConfiguration) string {
+		proposal := c.IPsecEncryption
+		if !vpnPeerIsGcm(c.IPsecEncryption) && c.IPsecAuthentication != "disabled" {
+			proposal += "-" + strings.Replace(c.IPsecAuthentication, "sha", "sha2_", 1)
+		}
+		if group := vpnPeerPfsGroup(c.IPsecPfs); group != 0 {
+			proposal += "-" + vpnPeerDhGroups[group]
+		}
+		return proposal
+	},
+	"cidrMask": func(cidr string) string {
+		parts := strings.SplitN(cidr, "/", 2)
+		if len(parts) != 2 {
+			return cidr + " 255.255.255.255"
+		}
+		var bits uint
+		fmt.Sscanf(parts[1], "%d", &bits)
+		mask := ^uint32(0) << (32 - bits)
+		if bits == 0 {
+			mask = 0
+		}
+		return fmt.Sprintf("%s %d.%d.%d.%d", parts[0], mask>>24, (mask>>16)&0xff, (mask>>8)&0xff, mask&0xff)
+	},
+	"sha": func(auth string) string {
+		return strings.Replace(auth, "sha", "sha-", 1)
+	},
+}
+
+var vpnPeerConfigurationTemplates = map[string]string{
+	vpnPeerDeviceStrongSwan: `# strongSwan configuration for IBM Cloud VPN gateway connection {{.ConnectionName}}
+# /etc/ipsec.conf
+config setup
+    charondebug="ike 1, knl 1, cfg 0"
+
+conn %default
+    keyexchange=ikev{{.IkeVersion}}
+    authby=secret
+    ike={{swanIke .}}!
+    esp={{swanEsp .}}!
+    ikelifetime={{.IkeKeyLifetime}}s
+    lifetime={{.IPsecKeyLifetime}}s
+    dpddelay={{.DpdInterval}}s
+    dpdtimeout={{.DpdTimeout}}s
+    dpdaction=restart
+    auto=start
+{{range $i, $ip := .GatewayPublicIPs}}
+conn {{$.ConnectionName}}-{{inc $i}}
+    left=%defaultroute
+    leftid={{$.PeerAddress}}
+{{- if $.RouteMode}}
+    leftsubnet=0.0.0.0/0
+    rightsubnet=0.0.0.0/0
+    mark={{inc $i}}
+{{- else}}
+    leftsubnet={{join $.PeerCIDRs ","}}
+    rightsubnet={{join $.LocalCIDRs ","}}
+{{- end}}
+    right={{$ip}}
+    rightid={{$ip}}
+{{end}}
+# /etc/ipsec.secrets
+{{range .GatewayPublicIPs}}{{$.PeerAddress}} {{.}} : PSK "{{$.Psk}}"
+{{end}}
+{{- if .RouteMode}}
+# /etc/strongswan.d/charon/ibm-vti.conf
+# The traffic is routed through the VTI interfaces, not by policies of charon
+charon {
+    install_routes = no
+}
+
+# VTI interfaces, one per tunnel. The key matches the mark of the connection.
+# Route the IBM Cloud prefixes through the interfaces, or run BGP over them.
+{{range $i, $ip := .GatewayPublicIPs}}ip link add vti{{inc $i}} type vti remote {{$ip}} key {{inc $i}}
+{{- range $t := $.Tunnels}}{{if and (eq $t.PublicIP $ip) $t.NeighborIP}}
+ip address add {{$t.NeighborIP}}/30 dev vti{{inc $i}}
+{{- end}}{{end}}
+sysctl -w net.ipv4.conf.vti{{inc $i}}.disable_policy=1
+ip link set vti{{inc $i}} up
+{{end}}
+{{- end}}`,
+
+	vpnPeerDeviceLibreSwan: `# libreswan configuration for IBM Cloud VPN gateway connection {{.ConnectionName}}
+# /etc/ipsec.d/{{.ConnectionName}}.conf
+{{range $i, $ip := .GatewayPublicIPs}}
+conn {{$.ConnectionName}}-{{inc $i}}
+    authby=secret
+    ikev2={{if eq $.IkeVersion 2}}insist{{else}}no{{end}}
+    ike={{libreIke $}}
+    phase2alg={{libreEsp $}}
+    pfs={{if eq (pfsGroup $.IPsecPfs) 0}}no{{else}}yes{{end}}
+    ikelifetime={{$.IkeKeyLifetime}}s
+    salifetime={{$.IPsecKeyLifetime}}s
+    dpddelay={{$.DpdInterval}}
+    dpdtimeout={{$.DpdTimeout}}
+    dpdaction=restart
+    left=%defaultroute
+    leftid={{$.PeerAddress}}
+{{- if $.RouteMode}}
+    leftsubnet=0.0.0.0/0
+    rightsubnet=0.0.0.0/0
+    mark={{inc $i}}/0xffffffff
+    vti-interface=vti{{inc $i}}
+    vti-routing=no
+{{- else}}
+    leftsubnets={{"{"}}{{join $.PeerCIDRs " "}}{{"}"}}
+    rightsubnets={{"{"}}{{join $.LocalCIDRs " "}}{{"}"}}
+{{- end}}
+    right={{$ip}}
+    rightid={{$ip}}
+    auto=start
+{{end}}
+# /etc/ipsec.d/{{.ConnectionName}}.secrets
+{{range .GatewayPublicIPs}}{{$.PeerAddress}} {{.}} : PSK "{{$.Psk}}"
+{{end}}`,
+
+	vpnPeerDeviceCiscoASA: `! Cisco ASA configuration for IBM Cloud VPN gateway connection {{.ConnectionName}}
+!
+crypto ikev{{.IkeVersion}} policy 10
+{{- if eq .IkeVersion 2}}
+ encryption aes-{{keySize .IkeEncryption}}
+ integrity {{sha .IkeAuthentication}}
+ prf {{sha .IkeAuthentication}}
+{{- else}}
+ authentication pre-share
+ encryption aes-{{keySize .IkeEncryption}}
+ hash {{sha .IkeAuthentication}}
+{{- end}}
+ group {{.IkeDhGroup}}
+ lifetime seconds {{.IkeKeyLifetime}}
+crypto ikev{{.IkeVersion}} enable {{.PeerInterface}}
+!
+crypto ipsec ikev2 ipsec-proposal IBM-{{.ConnectionName}}
+{{- if isGcm .IPsecEncryption}}
+ protocol esp encryption aes-gcm-{{keySize .IPsecEncryption}}
+ protocol esp integrity null
+{{- else}}
+ protocol esp encryption aes-{{keySize .IPsecEncryption}}
+ protocol esp integrity {{sha .IPsecAuthentication}}
+{{- end}}
+!
+crypto ipsec profile IBM-{{.ConnectionName}}
+ set ikev2 ipsec-proposal IBM-{{.ConnectionName}}
+{{- if ne (pfsGroup .IPsecPfs) 0}}
+ set pfs group{{pfsGroup .IPsecPfs}}
+{{- end}}
+ set security-association lifetime seconds {{.IPsecKeyLifetime}}
+!
+{{- if .RouteMode}}
+{{- range $i, $ip := .GatewayPublicIPs}}
+interface Tunnel{{inc $i}}
+ nameif ibm-vti{{inc $i}}
+{{- range $t := $.Tunnels}}{{if eq $t.PublicIP $ip}}
+ ip address {{$t.NeighborIP}} 255.255.255.252
+{{- end}}{{end}}
+ tunnel source interface {{$.PeerInterface}}
+ tunnel destination {{$ip}}
+ tunnel mode ipsec ipv4
+ tunnel protection ipsec profile IBM-{{$.ConnectionName}}
+!
+{{- end}}
+{{- else}}
+{{range .PeerCIDRs}}{{$peer := .}}{{range $.LocalCIDRs}}access-list IBM-{{$.ConnectionName}} extended permit ip {{cidrMask $peer}} {{cidrMask .}}
+{{end}}{{end}}!
+{{- range $i, $ip := .GatewayPublicIPs}}
+crypto map IBM-MAP {{inc $i}}0 match address IBM-{{$.ConnectionName}}
+crypto map IBM-MAP {{inc $i}}0 set peer {{$ip}}
+crypto map IBM-MAP {{inc $i}}0 set ikev2 ipsec-proposal IBM-{{$.ConnectionName}}
+{{- if ne (pfsGroup $.IPsecPfs) 0}}
+crypto map IBM-MAP {{inc $i}}0 set pfs group{{pfsGroup $.IPsecPfs}}
+{{- end}}
+crypto map IBM-MAP {{inc $i}}0 set security-association lifetime seconds {{$.IPsecKeyLifetime}}
+{{- end}}
+crypto map IBM-MAP interface {{.PeerInterface}}
+!
+{{- end}}
+{{- range .GatewayPublicIPs}}
+tunnel-group {{.}} type ipsec-l2l
+tunnel-group {{.}} ipsec-attributes
+ ikev{{$.IkeVersion}} remote-authentication pre-shared-key {{$.Psk}}
+ ikev{{$.IkeVersion}} local-authentication pre-shared-key {{$.Psk}}
+ isakmp keepalive threshold {{$.DpdInterval}} retry {{$.DpdTimeout}}
+{{- end}}
+`,
+
+	vpnPeerDeviceJuniperSRX: `# Juniper SRX configuration for IBM Cloud VPN gateway connection {{.ConnectionName}}
+set security ike proposal ibm-ike-proposal authentication-method pre-shared-keys
+set security ike proposal ibm-ike-proposal dh-group group{{.IkeDhGroup}}
+set security ike proposal ibm-ike-proposal authentication-algorithm {{sha .IkeAuthentication}}
+set security ike proposal ibm-ike-proposal encryption-algorithm aes-{{keySize .IkeEncryption}}-cbc
+set security ike proposal ibm-ike-proposal lifetime-seconds {{.IkeKeyLifetime}}
+set security ike policy ibm-ike-policy proposals ibm-ike-proposal
+set security ike policy ibm-ike-policy pre-shared-key ascii-text "{{.Psk}}"
+set security ipsec proposal ibm-ipsec-proposal protocol esp
+{{- if isGcm .IPsecEncryption}}
+set security ipsec proposal ibm-ipsec-proposal encryption-algorithm aes-{{keySize .IPsecEncryption}}-gcm
+{{- else}}
+set security ipsec proposal ibm-ipsec-proposal authentication-algorithm hmac-{{sha .IPsecAuthentication}}-128
+set security ipsec proposal ibm-ipsec-proposal encryption-algorithm aes-{{keySize .IPsecEncryption}}-cbc
+{{- end}}
+set security ipsec proposal ibm-ipsec-proposal lifetime-seconds {{.IPsecKeyLifetime}}
+{{- if ne (pfsGroup .IPsecPfs) 0}}
+set security ipsec policy ibm-ipsec-policy perfect-forward-secrecy keys group{{pfsGroup .IPsecPfs}}
+{{- end}}
+set security ipsec policy ibm-ipsec-policy proposals ibm-ipsec-proposal
+{{- range $i, $ip := .GatewayPublicIPs}}
+set security ike gateway ibm-gw-{{inc $i}} ike-policy ibm-ike-policy
+set security ike gateway ibm-gw-{{inc $i}} address {{$ip}}
+set security ike gateway ibm-gw-{{inc $i}} dead-peer-detection interval {{$.DpdInterval}}
+set security ike gateway ibm-gw-{{inc $i}} external-interface {{$.PeerInterface}}
+set security ike gateway ibm-gw-{{inc $i}} local-identity hostname {{$.PeerAddress}}
+set security ike gateway ibm-gw-{{inc $i}} version v{{$.IkeVersion}}-only
+set interfaces st0 unit {{inc $i}} family inet
+{{- range $t := $.Tunnels}}{{if eq $t.PublicIP $ip}}
+set interfaces st0 unit {{inc $i}} family inet address {{$t.NeighborIP}}/30
+{{- end}}{{end}}
+set security ipsec vpn ibm-vpn-{{inc $i}} bind-interface st0.{{inc $i}}
+set security ipsec vpn ibm-vpn-{{inc $i}} ike gateway ibm-gw-{{inc $i}}
+set security ipsec vpn ibm-vpn-{{inc $i}} ike ipsec-policy ibm-ipsec-policy
+set security ipsec vpn ibm-vpn-{{inc $i}} establish-tunnels immediately
+{{- if not $.RouteMode}}
+{{- range $j, $peer := $.PeerCIDRs}}{{range $k, $local := $.LocalCIDRs}}
+set security ipsec vpn ibm-vpn-{{inc $i}} traffic-selector ts-{{inc $j}}-{{inc $k}} local-ip {{$peer}} remote-ip {{$local}}
+{{- end}}{{end}}
+{{- end}}
+{{- end}}
+`,
+
+	vpnPeerDevicePfSense: `# pfSense settings for IBM Cloud VPN gateway connection {{.ConnectionName}}
+# Create one phase 1 entry per tunnel under VPN > IPsec > Tunnels.
+{{range $i, $ip := .GatewayPublicIPs}}
+[Phase 1: {{$.ConnectionName}}-{{inc $i}}]
+Key Exchange version   = IKEv{{$.IkeVersion}}
+Internet Protocol      = IPv4
+Interface              = {{$.PeerInterface}}
+Remote Gateway         = {{$ip}}
+Authentication Method  = Mutual PSK
+My identifier          = {{$.PeerAddress}}
+Peer identifier        = {{$ip}}
+Pre-Shared Key         = {{$.Psk}}
+Encryption Algorithm   = AES {{keySize $.IkeEncryption}} bits
+Hash Algorithm         = {{$.IkeAuthentication}}
+DH Group               = {{$.IkeDhGroup}}
+Life Time              = {{$.IkeKeyLifetime}}
+Dead Peer Detection    = delay {{$.DpdInterval}}, max failures {{$.DpdTimeout}}
+{{if $.RouteMode}}
+[Phase 2: {{$.ConnectionName}}-{{inc $i}}]
+Mode                   = Routed (VTI)
+{{- range $t := $.Tunnels}}{{if eq $t.PublicIP $ip}}
+Local Network          = {{$t.NeighborIP}}/30
+{{- if $t.TunnelInterfaceIP}}
+Remote Network         = {{$t.TunnelInterfaceIP}}
+{{- end}}
+{{- end}}{{end}}
+Protocol               = ESP
+Encryption Algorithm   = AES{{if isGcm $.IPsecEncryption}}-GCM{{end}} {{keySize $.IPsecEncryption}} bits
+Hash Algorithm         = {{if isGcm $.IPsecEncryption}}none{{else}}{{$.IPsecAuthentication}}{{end}}
+PFS key group          = {{if eq (pfsGroup $.IPsecPfs) 0}}off{{else}}{{pfsGroup $.IPsecPfs}}{{end}}
+Life Time              = {{$.IPsecKeyLifetime}}
+{{else}}{{range $j, $peer := $.PeerCIDRs}}{{range $k, $local := $.LocalCIDRs}}
+[Phase 2: {{$.ConnectionName}}-{{inc $i}}-{{inc $j}}-{{inc $k}}]
+Mode                   = Tunnel IPv4
+Local Network          = {{$peer}}
+Remote Network         = {{$local}}
+Protocol               = ESP
+Encryption Algorithm   = AES{{if isGcm $.IPsecEncryption}}-GCM{{end}} {{keySize $.IPsecEncryption}} bits
+Hash Algorithm         = {{if isGcm $.IPsecEncryption}}none{{else}}{{$.IPsecAuthentication}}{{end}}
+PFS key group          = {{if eq (pfsGroup $.IPsecPfs) 0}}off{{else}}{{pfsGroup $.IPsecPfs}}{{end}}
+Life Time              = {{$.IPsecKeyLifetime}}
+{{end}}{{end}}{{end}}{{end}}`,
+}
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccIBMIsVPNGatewayConnectionPeerConfigurationDataSourceBasic(t *testing.T) {
+	vpcname := fmt.Sprintf("tfvpnuat-vpc-%d", acctest.RandIntRange(100, 200))
+	subnetname := fmt.Sprintf("tfvpnuat-subnet-%d", acctest.RandIntRange(100, 200))
+	vpngwname := fmt.Sprintf("tfvpnuat-vpngw-%d", acctest.RandIntRange(100, 200))
+	name := fmt.Sprintf("tfvpnuat-createname-%d", acctest.RandIntRange(100, 200))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIsVPNGatewayConnectionPeerConfigurationDataSourceConfigBasic(vpcname, subnetname, vpngwname, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_gateway_connection_peer_configuration.example", "mode", "policy"),
+					resource.TestCheckResourceAttr("data.ibm_is_vpn_gateway_connection_peer_configuration.example", "ike_proposal.0.ike_version", "2"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_gateway_connection_peer_configuration.example", "vpn_gateway_public_ips.0"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_gateway_connection_peer_configuration.example", "local_cidrs.0"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_gateway_connection_peer_configuration.example", "peer_cidrs.0"),
+					resource.TestCheckResourceAttrSet("data.ibm_is_vpn_gateway_connection_peer_configuration.example", "peer_configuration"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIsVPNGatewayConnectionPeerConfigurationDataSourceConfigBasic(vpc, subnet, vpngwname, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "example" {
+		name = "%s"
+	}
+	resource "ibm_is_subnet" "example" {
+		name            = "%s"
+		vpc             = ibm_is_vpc.example.id
+		zone            = "%s"
+		ipv4_cidr_block = "%s"
+	}
+	resource "ibm_is_vpn_gateway" "example" {
+		name   = "%s"
+		subnet = ibm_is_subnet.example.id
+		mode   = "policy"
+	}
+	resource "ibm_is_vpn_gateway_connection" "example" {
+		name          = "%s"
+		vpn_gateway   = ibm_is_vpn_gateway.example.id
+		peer_address  = "1.2.3.4"
+		peer_cidrs    = ["192.168.10.0/24"]
+		local_cidrs   = [ibm_is_subnet.example.ipv4_cidr_block]
+		preshared_key = "VPNDemoPassword"
+	}
+	data "ibm_is_vpn_gateway_connection_peer_configuration" "example" {
+		vpn_gateway            = ibm_is_vpn_gateway.example.id
+		vpn_gateway_connection = ibm_is_vpn_gateway_connection.example.gateway_connection
+		device_type            = "strongswan"
+	}
+	`, vpc, subnet, acc.ISZoneName, acc.ISCIDR, vpngwname, name)
+}
+
+func testVPNPeerConfiguration(mode string) vpc.VPNPeerConfiguration {
+	return vpc.VPNPeerConfiguration{
+		ConnectionName:      "example",
+		Mode:                mode,
+		PeerInterface:       "outside",
+		PeerAddress:         "1.2.3.4",
+		Psk:                 "VPNDemoPassword",
+		GatewayPublicIPs:    []string{"169.61.161.150", "169.61.161.151"},
+		LocalCIDRs:          []string{"10.240.0.0/24"},
+		PeerCIDRs:           []string{"192.168.10.0/24"},
+		IkeVersion:          2,
+		IkeEncryption:       "aes256",
+		IkeAuthentication:   "sha384",
+		IkeDhGroup:          20,
+		IkeKeyLifetime:      28800,
+		IPsecEncryption:     "aes256gcm16",
+		IPsecAuthentication: "disabled",
+		IPsecPfs:            "group_20",
+		IPsecKeyLifetime:    3600,
+		DpdInterval:         2,
+		DpdTimeout:          10,
+	}
+}
+
+func TestRenderVPNPeerConfigurationStrongSwan(t *testing.T) {
+	result, err := vpc.RenderVPNPeerConfiguration("strongswan", testVPNPeerConfiguration("policy"))
+	assert.Nil(t, err)
+	assert.Contains(t, result, "ike=aes256-sha384-ecp384!")
+	assert.Contains(t, result, "esp=aes256gcm16-ecp384!")
+	assert.Contains(t, result, "conn example-1")
+	assert.Contains(t, result, "right=169.61.161.151")
+	assert.Contains(t, result, "leftsubnet=192.168.10.0/24")
+	assert.Contains(t, result, "rightsubnet=10.240.0.0/24")
+	assert.Contains(t, result, `1.2.3.4 169.61.161.150 : PSK "VPNDemoPassword"`)
+	assert.NotContains(t, result, "vti")
+}
+
+func TestRenderVPNPeerConfigurationStrongSwanRouteMode(t *testing.T) {
+	peerConfig := testVPNPeerConfiguration("route")
+	peerConfig.Tunnels = []vpc.VPNPeerTunnel{{PublicIP: "169.61.161.150", TunnelInterfaceIP: "169.254.0.1", NeighborIP: "169.254.0.2"}}
+	result, err := vpc.RenderVPNPeerConfiguration("strongswan", peerConfig)
+	assert.Nil(t, err)
+	assert.Contains(t, result, "mark=2")
+	assert.Contains(t, result, "install_routes = no")
+	assert.Contains(t, result, "ip link add vti1 type vti remote 169.61.161.150 key 1\nip address add 169.254.0.2/30 dev vti1\n")
+	assert.Contains(t, result, "ip link add vti2 type vti remote 169.61.161.151 key 2\nsysctl -w net.ipv4.conf.vti2.disable_policy=1\n")
+	assert.NotContains(t, result, "leftsubnet=192.168.10.0/24")
+}
+
+func TestRenderVPNPeerConfigurationLibreSwan(t *testing.T) {
+	result, err := vpc.RenderVPNPeerConfiguration("libreswan", testVPNPeerConfiguration("route"))
+	assert.Nil(t, err)
+	assert.Contains(t, result, "ike=aes256-sha2_384-ecp384")
+	assert.Contains(t, result, "phase2alg=aes256gcm16-ecp384")
+	assert.Contains(t, result, "vti-interface=vti2")
+	assert.NotContains(t, result, "leftsubnets=")
+}
+
+func TestRenderVPNPeerConfigurationCiscoASA(t *testing.T) {
+	result, err := vpc.RenderVPNPeerConfiguration("cisco_asa", testVPNPeerConfiguration("policy"))
+	assert.Nil(t, err)
+	assert.Contains(t, result, "access-list IBM-example extended permit ip 192.168.10.0 255.255.255.0 10.240.0.0 255.255.255.0")
+	assert.Contains(t, result, "protocol esp encryption aes-gcm-256")
+	assert.Contains(t, result, "crypto map IBM-MAP 20 set peer 169.61.161.151")
+	assert.Contains(t, result, "set pfs group20")
+}
+
+func TestRenderVPNPeerConfigurationJuniperSRX(t *testing.T) {
+	peerConfig := testVPNPeerConfiguration("route")
+	peerConfig.Tunnels = []vpc.VPNPeerTunnel{{PublicIP: "169.61.161.150", TunnelInterfaceIP: "169.254.0.1", NeighborIP: "169.254.0.2"}}
+	result, err := vpc.RenderVPNPeerConfiguration("juniper_srx", peerConfig)
+	assert.Nil(t, err)
+	assert.Contains(t, result, "set security ike proposal ibm-ike-proposal dh-group group20")
+	assert.Contains(t, result, "set interfaces st0 unit 1 family inet address 169.254.0.2/30")
+	assert.Contains(t, result, "set security ike gateway ibm-gw-2 address 169.61.161.151")
+	assert.NotContains(t, result, "traffic-selector")
+}
+
+func TestRenderVPNPeerConfigurationPfSense(t *testing.T) {
+	result, err := vpc.RenderVPNPeerConfiguration("pfsense", testVPNPeerConfiguration("policy"))
+	assert.Nil(t, err)
+	assert.Contains(t, result, "Remote Gateway         = 169.61.161.150")
+	assert.Contains(t, result, "Encryption Algorithm   = AES-GCM 256 bits")
+	assert.Contains(t, result, "PFS key group          = 20")
+}
+
+func TestRenderVPNPeerConfigurationErrors(t *testing.T) {
+	_, err := vpc.RenderVPNPeerConfiguration("unknown", testVPNPeerConfiguration("policy"))
+	assert.NotNil(t, err)
+
+	peerConfig := testVPNPeerConfiguration("policy")
+	peerConfig.GatewayPublicIPs = nil
+	_, err = vpc.RenderVPNPeerConfiguration("strongswan", peerConfig)
+	assert.NotNil(t, err)
+}
@@ -8,8 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
+	"net/http"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -110,6 +113,27 @@ const (
 	isInstanceLifecycleReasonsMessage  = "message"
 	isInstanceLifecycleReasonsMoreInfo = "more_info"
 
+	isInstanceWaitForReady               = "wait_for_ready"
+	isInstanceWaitForReadyType           = "type"
+	isInstanceWaitForReadyAddress        = "address"
+	isInstanceWaitForReadyPort           = "port"
+	isInstanceWaitForReadyPath           = "path"
+	isInstanceWaitForReadyExpectedStatus = "expected_status"
+	isInstanceWaitForReadyTag            = "tag"
+	isInstanceWaitForReadyFailureTag     = "failure_tag"
+	isInstanceWaitForReadyTimeout        = "timeout"
+	isInstanceWaitForReadyInterval       = "interval"
+	isInstanceWaitForReadyOnFailure      = "on_failure"
+	isInstanceReadyTypeTCP               = "tcp"
+	isInstanceReadyTypeHTTP              = "http"
+	isInstanceReadyTypeTag               = "user_tag"
+	isInstanceReadyOnFailureFail         = "fail"
+	isInstanceReadyOnFailureTaint        = "taint"
+	isInstanceReadyOnFailureWarn         = "warn"
+
+	isInstanceProfileChangeImpact = "profile_change_impact"
+	isInstanceProfileFamilyGpu    = "gpu"
+
 	isInstanceCatalogOffering            = "catalog_offering"
 	isInstanceCatalogOfferingOfferingCrn = "offering_crn"
 	isInstanceCatalogOfferingVersionCrn  = "version_crn"
@@ -189,6 +213,14 @@ func ResourceIBMISInstance() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceWaitForReadyValidate(diff)
+				}),
+			customdiff.Sequence(
+				func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISInstanceProfileChangePreflight(context, diff, v)
+				}),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -479,6 +511,11 @@ func ResourceIBMISInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Profile info",
 			},
+			isInstanceProfileChangeImpact: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The impact of the most recent planned change of profile or threads_per_core, such as the downtime it causes and the reason for it. It is kept in the state after the change is applied",
+			},
 			isInstanceDefaultTrustedProfileAutoLink: {
 				Type:         schema.TypeBool,
 				Optional:     true,
@@ -601,6 +638,77 @@ func ResourceIBMISInstance() *schema.Resource {
 				Description:  "If set to true, the action will be forced immediately, and all queued actions deleted. Ignored for the start action.",
 			},
 
+			isInstanceWaitForReady: {
+				Type:        schema.TypeList,
+				MaxItems:    1,
+				Optional:    true,
+				Description: "Waits on create until the instance signals that it is ready, for example after user_data has finished",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						isInstanceWaitForReadyType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "wait_for_ready_type"),
+							Description:  "The readiness signal to wait for: tcp, http or user_tag",
+						},
+						isInstanceWaitForReadyAddress: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The address to probe for the tcp and http types. Defaults to the primary IP address of the instance",
+						},
+						isInstanceWaitForReadyPort: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "wait_for_ready_port"),
+							Description:  "The port to probe for the tcp and http types. Required for tcp, defaults to 80 for http",
+						},
+						isInstanceWaitForReadyPath: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "/",
+							Description: "The path to request for the http type",
+						},
+						isInstanceWaitForReadyExpectedStatus: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     200,
+							Description: "The HTTP status code that signals readiness for the http type",
+						},
+						isInstanceWaitForReadyTag: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The user tag that the instance attaches to itself with the Global Tagging API when it is ready, for the user_tag type",
+						},
+						isInstanceWaitForReadyFailureTag: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The user tag that the instance attaches to itself with the Global Tagging API when bootstrapping failed, for the user_tag type",
+						},
+						isInstanceWaitForReadyTimeout: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "wait_for_ready_timeout"),
+							Description:  "The time in minutes to wait for the instance to become ready",
+						},
+						isInstanceWaitForReadyInterval: {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      15,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "wait_for_ready_interval"),
+							Description:  "The time in seconds between readiness checks",
+						},
+						isInstanceWaitForReadyOnFailure: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      isInstanceReadyOnFailureFail,
+							ValidateFunc: validate.InvokeValidator("ibm_is_instance", "wait_for_ready_on_failure"),
+							Description:  "The behaviour when the instance does not become ready: fail deletes the instance and returns an error, taint returns an error and keeps the instance for inspection, warn only returns a warning",
+						},
+					},
+				},
+			},
+
 			isInstanceVolumeAttachments: {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -2397,6 +2505,45 @@ func ResourceIBMISInstanceValidator() *validate.ResourceValidator {
 		AllowedValues:              "1, 2",
 	})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "wait_for_ready_type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "tcp, http, user_tag"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "wait_for_ready_port",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "65535"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "wait_for_ready_timeout",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "120"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "wait_for_ready_interval",
+			ValidateFunctionIdentifier: validate.IntBetween,
+			Type:                       validate.TypeInt,
+			Optional:                   true,
+			MinValue:                   "5",
+			MaxValue:                   "300"})
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "wait_for_ready_on_failure",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "fail, taint, warn"})
+
 	ibmISInstanceValidator := validate.ResourceValidator{ResourceName: "ibm_is_instance", Schema: validateSchema}
 	return &ibmISInstanceValidator
 }
@@ -5470,7 +5617,212 @@ func resourceIBMisInstanceCreate(context context.Context, d *schema.ResourceData
 		}
 	}
 
-	return resourceIBMisInstanceUpdate(context, d, meta)
+	var diags diag.Diagnostics
+	if _, ok := d.GetOk(isInstanceWaitForReady); ok {
+		diags = instanceWaitForReady(context, d, meta)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceIBMisInstanceUpdate(context, d, meta)...)
+}
+
+// instanceWaitForReady waits for the readiness signal configured in
+// wait_for_ready and applies the on_failure behaviour when it is not received.
+func instanceWaitForReady(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance", "create", "initialize-client")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	id := d.Id()
+	instance, _, err := instanceC.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("GetInstanceWithContext failed: %s", err.Error()), "ibm_is_instance", "create")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	waitForReady := d.Get(isInstanceWaitForReady).([]interface{})[0].(map[string]interface{})
+	readyCheck, err := expandInstanceReadyCheck(waitForReady, instance)
+	if err == nil {
+		_, err = isWaitForInstanceReady(context, meta, *instance.CRN, readyCheck)
+	}
+	if err == nil {
+		return nil
+	}
+
+	switch waitForReady[isInstanceWaitForReadyOnFailure].(string) {
+	case isInstanceReadyOnFailureWarn:
+		log.Printf("[WARN] Instance (%s) did not become ready: %s", id, err)
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Instance (%s) did not become ready", id),
+			Detail:   err.Error(),
+		}}
+	case isInstanceReadyOnFailureFail:
+		if diags := instanceDelete(context, d, meta, id); diags.HasError() {
+			return append(diags, diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Instance (%s) did not become ready and could not be deleted", id),
+				Detail:   err.Error(),
+			}}...)
+		}
+		d.SetId("")
+	}
+	tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceReady failed: %s", err.Error()), "ibm_is_instance", "create")
+	log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+	return tfErr.GetDiag()
+}
+
+func resourceIBMISInstanceWaitForReadyValidate(diff *schema.ResourceDiff) error {
+	waitForReady, ok := diff.GetOk(isInstanceWaitForReady)
+	if !ok || len(waitForReady.([]interface{})) == 0 || waitForReady.([]interface{})[0] == nil {
+		return nil
+	}
+	readyCheck := waitForReady.([]interface{})[0].(map[string]interface{})
+	switch readyCheck[isInstanceWaitForReadyType].(string) {
+	case isInstanceReadyTypeTag:
+		if readyCheck[isInstanceWaitForReadyTag].(string) == "" && diff.NewValueKnown("wait_for_ready.0.tag") {
+			return fmt.Errorf("[ERROR] wait_for_ready.0.tag is required for the user_tag type")
+		}
+	case isInstanceReadyTypeTCP:
+		if readyCheck[isInstanceWaitForReadyPort].(int) == 0 && diff.NewValueKnown("wait_for_ready.0.port") {
+			return fmt.Errorf("[ERROR] wait_for_ready.0.port is required for the tcp type")
+		}
+	}
+	return nil
+}
+
+// InstanceReadyCheck describes the readiness signal of an instance.
+type InstanceReadyCheck struct {
+	Type           string
+	Address        string
+	Port           int
+	Path           string
+	ExpectedStatus int
+	Tag            string
+	FailureTag     string
+	Timeout        time.Duration
+	Interval       time.Duration
+}
+
+func expandInstanceReadyCheck(waitForReady map[string]interface{}, instance *vpcv1.Instance) (*InstanceReadyCheck, error) {
+	readyCheck := &InstanceReadyCheck{
+		Type:           waitForReady[isInstanceWaitForReadyType].(string),
+		Address:        waitForReady[isInstanceWaitForReadyAddress].(string),
+		Port:           waitForReady[isInstanceWaitForReadyPort].(int),
+		Path:           waitForReady[isInstanceWaitForReadyPath].(string),
+		ExpectedStatus: waitForReady[isInstanceWaitForReadyExpectedStatus].(int),
+		Tag:            waitForReady[isInstanceWaitForReadyTag].(string),
+		FailureTag:     waitForReady[isInstanceWaitForReadyFailureTag].(string),
+		Timeout:        time.Duration(waitForReady[isInstanceWaitForReadyTimeout].(int)) * time.Minute,
+		Interval:       time.Duration(waitForReady[isInstanceWaitForReadyInterval].(int)) * time.Second,
+	}
+	switch readyCheck.Type {
+	case isInstanceReadyTypeTag:
+		if readyCheck.Tag == "" {
+			return nil, fmt.Errorf("[ERROR] wait_for_ready.0.tag is required for the user_tag type")
+		}
+		return readyCheck, nil
+	case isInstanceReadyTypeTCP:
+		if readyCheck.Port == 0 {
+			return nil, fmt.Errorf("[ERROR] wait_for_ready.0.port is required for the tcp type")
+		}
+	case isInstanceReadyTypeHTTP:
+		if readyCheck.Port == 0 {
+			readyCheck.Port = 80
+		}
+	}
+	if readyCheck.Address == "" {
+		if instance.PrimaryNetworkAttachment != nil && instance.PrimaryNetworkAttachment.PrimaryIP != nil {
+			readyCheck.Address = flex.StringValue(instance.PrimaryNetworkAttachment.PrimaryIP.Address)
+		} else if instance.PrimaryNetworkInterface != nil && instance.PrimaryNetworkInterface.PrimaryIP != nil {
+			readyCheck.Address = flex.StringValue(instance.PrimaryNetworkInterface.PrimaryIP.Address)
+		}
+		if readyCheck.Address == "" {
+			return nil, fmt.Errorf("[ERROR] wait_for_ready.0.address is not set and the instance has no primary IP address")
+		}
+	}
+	return readyCheck, nil
+}
+
+func isWaitForInstanceReady(context context.Context, meta interface{}, crn string, readyCheck *InstanceReadyCheck) (interface{}, error) {
+	log.Printf("Waiting for instance (%s) to be ready.", crn)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"waiting"},
+		Target:       []string{"ready"},
+		Refresh:      isInstanceReadyRefreshFunc(context, meta, crn, readyCheck),
+		Timeout:      readyCheck.Timeout,
+		PollInterval: readyCheck.Interval,
+	}
+
+	return stateConf.WaitForStateContext(context)
+}
+
+func isInstanceReadyRefreshFunc(context context.Context, meta interface{}, crn string, readyCheck *InstanceReadyCheck) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if readyCheck.Type == isInstanceReadyTypeTag {
+			tags, err := flex.GetGlobalTagsUsingCRN(meta, crn, "", isInstanceUserTagType)
+			if err != nil {
+				log.Printf("[DEBUG] Error getting tags of instance (%s): %s", crn, err)
+				return readyCheck, "waiting", nil
+			}
+			if readyCheck.FailureTag != "" && tags.Contains(readyCheck.FailureTag) {
+				return readyCheck, "failed", fmt.Errorf("[ERROR] Instance (%s) signalled a failure with tag %s", crn, readyCheck.FailureTag)
+			}
+			if tags.Contains(readyCheck.Tag) {
+				return readyCheck, "ready", nil
+			}
+			return readyCheck, "waiting", nil
+		}
+		if err := ProbeInstanceReady(context, readyCheck); err != nil {
+			log.Printf("[DEBUG] Instance (%s) is not ready yet: %s", crn, err)
+			return readyCheck, "waiting", nil
+		}
+		return readyCheck, "ready", nil
+	}
+}
+
+// ProbeInstanceReady probes the tcp or http endpoint of a readiness check once.
+func ProbeInstanceReady(context context.Context, readyCheck *InstanceReadyCheck) error {
+	address := net.JoinHostPort(readyCheck.Address, strconv.Itoa(readyCheck.Port))
+	probeTimeout := readyCheck.Interval
+	if probeTimeout <= 0 || probeTimeout > 30*time.Second {
+		probeTimeout = 30 * time.Second
+	}
+	switch readyCheck.Type {
+	case isInstanceReadyTypeTCP:
+		conn, err := (&net.Dialer{Timeout: probeTimeout}).DialContext(context, "tcp", address)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case isInstanceReadyTypeHTTP:
+		path := readyCheck.Path
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		request, err := http.NewRequestWithContext(context, http.MethodGet, "http://"+address+path, nil)
+		if err != nil {
+			return err
+		}
+		response, err := (&http.Client{Timeout: probeTimeout}).Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		if response.StatusCode != readyCheck.ExpectedStatus {
+			return fmt.Errorf("unexpected status code %d, expected %d", response.StatusCode, readyCheck.ExpectedStatus)
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported readiness check type %s", readyCheck.Type)
 }
 
 func isWaitForInstanceAvailable(instanceC *vpcv1.VpcV1, id string, timeout time.Duration, d *schema.ResourceData) (interface{}, error) {
@@ -7933,6 +8285,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 
 	if (profileChanged || bandwidthChanged || threadsPerCoreChanged) && !d.IsNewResource() {
 		var needsRestart bool = false
+		var wasRunning bool = false
 
 		// Profile and threads_per_core both require a stop/start cycle;
 		// bandwidth alone does not.
@@ -7953,6 +8306,7 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 			}
 
 			if instance != nil && *instance.Status == "running" {
+				wasRunning = true
 				actiontype := "stop"
 				createinsactoptions := &vpcv1.CreateInstanceActionOptions{
 					InstanceID: &id,
@@ -8017,6 +8371,9 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 
 		_, response, err := instanceC.UpdateInstanceWithContext(context, updnetoptions)
 		if err != nil {
+			if needsRestart {
+				return instanceRollbackProfileChange(context, instanceC, d, id, false, wasRunning, err)
+			}
 			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("UpdateInstanceWithContext failed: %s", err.Error()), "ibm_is_instance", "update")
 			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 			return tfErr.GetDiag()
@@ -8034,15 +8391,11 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 				if response != nil && response.StatusCode == 404 {
 					return nil
 				}
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("CreateInstanceActionWithContext failed: %s", err.Error()), "ibm_is_instance", "update")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
+				return instanceRollbackProfileChange(context, instanceC, d, id, true, wasRunning, err)
 			}
 			_, err = isWaitForInstanceAvailable(instanceC, d.Id(), d.Timeout(schema.TimeoutUpdate), d)
 			if err != nil {
-				tfErr := flex.TerraformErrorf(err, fmt.Sprintf("isWaitForInstanceAvailable failed: %s", err.Error()), "ibm_is_instance", "update")
-				log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
-				return tfErr.GetDiag()
+				return instanceRollbackProfileChange(context, instanceC, d, id, true, wasRunning, err)
 			}
 		}
 	}
@@ -8075,6 +8428,275 @@ func instanceUpdate(context context.Context, d *schema.ResourceData, meta interf
 	return nil
 }
 
+// instanceRollbackProfileChange restores the previous profile, threads_per_core
+// and total_volume_bandwidth of an instance after a failed profile change, and
+// starts the instance again if it was running before the change. patched
+// reports whether the new values were already applied to the instance.
+func instanceRollbackProfileChange(context context.Context, instanceC *vpcv1.VpcV1, d *schema.ResourceData, id string, patched, wasRunning bool, cause error) diag.Diagnostics {
+	oldProfile, _ := d.GetChange(isInstanceProfile)
+	log.Printf("[WARN] Profile change of instance (%s) failed, restoring profile %s: %s", id, oldProfile, cause)
+
+	rollbackErr := func(err error) diag.Diagnostics {
+		err = fmt.Errorf("[ERROR] Error changing the profile of instance (%s): %s\n[ERROR] Error restoring the previous profile %s: %s", id, cause, oldProfile, err)
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	if patched {
+		instance, _, err := instanceC.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{
+			ID: &id,
+		})
+		if err != nil {
+			return rollbackErr(err)
+		}
+		if *instance.Status != isInstanceActionStatusStopped && *instance.Status != "failed" {
+			_, _, err = instanceC.CreateInstanceActionWithContext(context, &vpcv1.CreateInstanceActionOptions{
+				InstanceID: &id,
+				Type:       core.StringPtr("stop"),
+				Force:      core.BoolPtr(true),
+			})
+			if err != nil {
+				return rollbackErr(err)
+			}
+			if _, err = isWaitForInstanceActionStop(instanceC, d.Timeout(schema.TimeoutUpdate), id, d); err != nil {
+				return rollbackErr(err)
+			}
+		}
+
+		instancePatchModel := &vpcv1.InstancePatch{}
+		if d.HasChange(isInstanceProfile) {
+			instancePatchModel.Profile = &vpcv1.InstancePatchProfile{
+				Name: core.StringPtr(oldProfile.(string)),
+			}
+		}
+		if d.HasChange(isInstanceThreadsPerCore) {
+			if oldThreadsPerCore, _ := d.GetChange(isInstanceThreadsPerCore); oldThreadsPerCore.(int) != 0 {
+				instancePatchModel.ThreadsPerCore = core.Int64Ptr(int64(oldThreadsPerCore.(int)))
+			}
+		}
+		if d.HasChange(isInstanceTotalVolumeBandwidth) {
+			if oldBandwidth, _ := d.GetChange(isInstanceTotalVolumeBandwidth); oldBandwidth.(int) != 0 {
+				instancePatchModel.TotalVolumeBandwidth = core.Int64Ptr(int64(oldBandwidth.(int)))
+			}
+		}
+		instancePatch, err := instancePatchModel.AsPatch()
+		if err != nil {
+			return rollbackErr(err)
+		}
+		_, _, err = instanceC.UpdateInstanceWithContext(context, &vpcv1.UpdateInstanceOptions{
+			ID:            &id,
+			InstancePatch: instancePatch,
+		})
+		if err != nil {
+			return rollbackErr(err)
+		}
+	}
+
+	if !wasRunning {
+		err := fmt.Errorf("[ERROR] Error changing the profile of instance (%s), the previous profile %s was restored and the instance was left stopped: %s", id, oldProfile, cause)
+		tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance", "update")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+
+	_, _, err := instanceC.CreateInstanceActionWithContext(context, &vpcv1.CreateInstanceActionOptions{
+		InstanceID: &id,
+		Type:       core.StringPtr("start"),
+	})
+	if err != nil {
+		return rollbackErr(err)
+	}
+	if _, err = isWaitForInstanceAvailable(instanceC, id, d.Timeout(schema.TimeoutUpdate), d); err != nil {
+		return rollbackErr(err)
+	}
+
+	err = fmt.Errorf("[ERROR] Error changing the profile of instance (%s), the previous profile %s was restored and the instance was started: %s", id, oldProfile, cause)
+	tfErr := flex.TerraformErrorf(err, err.Error(), "ibm_is_instance", "update")
+	log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+	return tfErr.GetDiag()
+}
+
+// resourceIBMISInstanceProfileChangePreflight checks a planned profile change
+// against the target profile before the instance is stopped, and reports the
+// impact of the change in profile_change_impact. Apply keeps the planned value.
+func resourceIBMISInstanceProfileChangePreflight(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+	profileChanged := diff.HasChange(isInstanceProfile) && diff.NewValueKnown(isInstanceProfile) && diff.Get(isInstanceProfile).(string) != ""
+	threadsPerCoreChanged := diff.HasChange(isInstanceThreadsPerCore)
+	if !profileChanged && !threadsPerCoreChanged {
+		return nil
+	}
+	oldProfile, newProfile := diff.GetChange(isInstanceProfile)
+
+	instanceC, err := vpcClient(meta)
+	if err != nil {
+		return err
+	}
+	id := diff.Id()
+	instance, _, err := instanceC.GetInstanceWithContext(context, &vpcv1.GetInstanceOptions{
+		ID: &id,
+	})
+	if err != nil {
+		log.Printf("[WARN] Skipping the profile change pre-flight check of instance (%s): %s", id, err)
+		return nil
+	}
+
+	var currentProfile, profile *vpcv1.InstanceProfile
+	if profileChanged {
+		currentProfile, _, err = instanceC.GetInstanceProfileWithContext(context, &vpcv1.GetInstanceProfileOptions{
+			Name: core.StringPtr(oldProfile.(string)),
+		})
+		if err != nil {
+			log.Printf("[WARN] Skipping the profile family check of instance (%s): %s", id, err)
+			currentProfile = nil
+		}
+		var response *core.DetailedResponse
+		profile, response, err = instanceC.GetInstanceProfileWithContext(context, &vpcv1.GetInstanceProfileOptions{
+			Name: core.StringPtr(newProfile.(string)),
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return fmt.Errorf("[ERROR] Instance profile %s was not found", newProfile)
+			}
+			log.Printf("[WARN] Skipping the profile change pre-flight check of instance (%s): %s", id, err)
+			return nil
+		}
+		var host *vpcv1.DedicatedHost
+		if placementTarget, ok := instance.PlacementTarget.(*vpcv1.InstancePlacementTarget); ok && placementTarget.ResourceType != nil && *placementTarget.ResourceType == "dedicated_host" {
+			host, _, err = instanceC.GetDedicatedHostWithContext(context, &vpcv1.GetDedicatedHostOptions{
+				ID: placementTarget.ID,
+			})
+			if err != nil {
+				log.Printf("[WARN] Skipping the dedicated host capacity check of instance (%s): %s", id, err)
+				host = nil
+			}
+		}
+		totalVolumeBandwidth := int64(0)
+		if v, ok := diff.GetOk(isInstanceTotalVolumeBandwidth); ok {
+			totalVolumeBandwidth = int64(v.(int))
+		}
+		if problems := CheckInstanceProfileChange(instance, currentProfile, profile, host, totalVolumeBandwidth); len(problems) > 0 {
+			return fmt.Errorf("[ERROR] Instance (%s) cannot be changed to profile %s:\n%s", id, newProfile, strings.Join(problems, "\n"))
+		}
+	}
+
+	return diff.SetNew(isInstanceProfileChangeImpact, InstanceProfileChangeImpact(instance, currentProfile, profile, profileChanged))
+}
+
+// InstanceProfileChangeImpact describes the downtime that a change of profile
+// or threads_per_core causes for an instance, and why. currentProfile and
+// profile are the current and target profiles of a profile change; either is
+// nil when it could not be read.
+func InstanceProfileChangeImpact(instance *vpcv1.Instance, currentProfile, profile *vpcv1.InstanceProfile, profileChanged bool) string {
+	change := "threads_per_core is changed"
+	reason := "the number of threads per core of a virtual server instance can only be changed while it is stopped"
+	if profileChanged {
+		change = "the profile is changed"
+		if currentProfile != nil && profile != nil {
+			change = fmt.Sprintf("the profile is changed from %s to %s", flex.StringValue(currentProfile.Name), flex.StringValue(profile.Name))
+		}
+		reason = "the vCPUs and memory of a virtual server instance can only be resized while it is stopped"
+		if currentProfile != nil && profile != nil && currentProfile.Family != nil && profile.Family != nil {
+			if *currentProfile.Family == *profile.Family {
+				reason += fmt.Sprintf(", even within the %s family", *profile.Family)
+			} else {
+				reason = fmt.Sprintf("moving from the %s family to the %s family changes the ratio of memory to vCPUs of the instance, which can only be done while it is stopped", *currentProfile.Family, *profile.Family)
+			}
+		}
+	}
+	if instance.Status != nil && *instance.Status == isInstanceStatusRunning {
+		return fmt.Sprintf("The instance is stopped while %s, because %s. It is started again afterwards and is unavailable until it is running again.", change, reason)
+	}
+	return fmt.Sprintf("The instance is not running, it is started after %s.", change)
+}
+
+// CheckInstanceProfileChange returns the reasons why an instance cannot be
+// changed from currentProfile, or nil when it is not known, to profile. host
+// is the dedicated host of the instance, if any, and totalVolumeBandwidth the
+// planned total volume bandwidth, or 0.
+func CheckInstanceProfileChange(instance *vpcv1.Instance, currentProfile, profile *vpcv1.InstanceProfile, host *vpcv1.DedicatedHost, totalVolumeBandwidth int64) []string {
+	problems := []string{}
+	profileName := flex.StringValue(profile.Name)
+
+	if profile.Status != nil && *profile.Status == "obsolete" {
+		problems = append(problems, fmt.Sprintf("profile %s is obsolete", profileName))
+	}
+	familyChanged := false
+	if currentProfile != nil && currentProfile.Family != nil && profile.Family != nil && *currentProfile.Family != *profile.Family &&
+		(*currentProfile.Family == isInstanceProfileFamilyGpu || *profile.Family == isInstanceProfileFamilyGpu) {
+		familyChanged = true
+		problems = append(problems, fmt.Sprintf("an instance cannot be moved into or out of the %s family, profile %s is in the %s family and profile %s in the %s family", isInstanceProfileFamilyGpu, flex.StringValue(currentProfile.Name), *currentProfile.Family, profileName, *profile.Family))
+	}
+	if instance.Vcpu != nil && instance.Vcpu.Architecture != nil && profile.VcpuArchitecture != nil && profile.VcpuArchitecture.Value != nil &&
+		*instance.Vcpu.Architecture != *profile.VcpuArchitecture.Value {
+		problems = append(problems, fmt.Sprintf("the vCPU architecture %s of profile %s does not match the architecture %s of the instance", *profile.VcpuArchitecture.Value, profileName, *instance.Vcpu.Architecture))
+	}
+
+	instanceHasGpu := instance.Gpu != nil && instance.Gpu.Count != nil && *instance.Gpu.Count > 0
+	profileHasGpu := false
+	if gpu, ok := profile.GpuCount.(*vpcv1.InstanceProfileGpu); ok && gpu != nil {
+		profileHasGpu = (gpu.Value != nil && *gpu.Value > 0) || (gpu.Max != nil && *gpu.Max > 0) || len(gpu.Values) > 0
+	}
+	if instanceHasGpu != profileHasGpu && !familyChanged {
+		problems = append(problems, "an instance cannot be changed between a profile with GPUs and a profile without GPUs")
+	}
+
+	if (len(instance.Disks) > 0) != (len(profile.Disks) > 0) {
+		problems = append(problems, "an instance cannot be changed between a profile with instance storage and a profile without instance storage")
+	}
+
+	if len(instance.NetworkAttachments) > 0 {
+		if count, ok := profile.NetworkAttachmentCount.(*vpcv1.InstanceProfileNetworkAttachmentCount); ok && count != nil && count.Max != nil && int64(len(instance.NetworkAttachments)) > *count.Max {
+			problems = append(problems, fmt.Sprintf("the instance has %d network attachments, profile %s supports at most %d", len(instance.NetworkAttachments), profileName, *count.Max))
+		}
+	} else if len(instance.NetworkInterfaces) > 0 {
+		if count, ok := profile.NetworkInterfaceCount.(*vpcv1.InstanceProfileNetworkInterfaceCount); ok && count != nil && count.Max != nil && int64(len(instance.NetworkInterfaces)) > *count.Max {
+			problems = append(problems, fmt.Sprintf("the instance has %d network interfaces, profile %s supports at most %d", len(instance.NetworkInterfaces), profileName, *count.Max))
+		}
+	}
+
+	if totalVolumeBandwidth > 0 {
+		if bandwidth, ok := profile.Bandwidth.(*vpcv1.InstanceProfileBandwidth); ok && bandwidth != nil {
+			maxBandwidth := int64(0)
+			if bandwidth.Value != nil {
+				maxBandwidth = *bandwidth.Value
+			} else if bandwidth.Max != nil {
+				maxBandwidth = *bandwidth.Max
+			}
+			if maxBandwidth > 0 && totalVolumeBandwidth >= maxBandwidth {
+				problems = append(problems, fmt.Sprintf("the total volume bandwidth %d must be less than the bandwidth %d of profile %s", totalVolumeBandwidth, maxBandwidth, profileName))
+			}
+		}
+	}
+
+	if host != nil {
+		supported := false
+		for _, supportedProfile := range host.SupportedInstanceProfiles {
+			if flex.StringValue(supportedProfile.Name) == profileName {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			problems = append(problems, fmt.Sprintf("profile %s is not supported by the dedicated host %s", profileName, flex.StringValue(host.Name)))
+		}
+		if vcpu, ok := profile.VcpuCount.(*vpcv1.InstanceProfileVcpu); ok && vcpu != nil && vcpu.Value != nil && instance.Vcpu != nil && instance.Vcpu.Count != nil && host.AvailableVcpu != nil && host.AvailableVcpu.Count != nil {
+			if required := *vcpu.Value - *instance.Vcpu.Count; required > *host.AvailableVcpu.Count {
+				problems = append(problems, fmt.Sprintf("the dedicated host %s has %d vCPUs available, profile %s requires %d more", flex.StringValue(host.Name), *host.AvailableVcpu.Count, profileName, required))
+			}
+		}
+		if memory, ok := profile.Memory.(*vpcv1.InstanceProfileMemory); ok && memory != nil && memory.Value != nil && instance.Memory != nil && host.AvailableMemory != nil {
+			if required := *memory.Value - *instance.Memory; required > *host.AvailableMemory {
+				problems = append(problems, fmt.Sprintf("the dedicated host %s has %d GB memory available, profile %s requires %d GB more", flex.StringValue(host.Name), *host.AvailableMemory, profileName, required))
+			}
+		}
+	}
+	return problems
+}
+
 func resourceIBMisInstanceUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	err := instanceUpdate(context, d, meta)
@@ -106,11 +106,22 @@ func ResourceIBMISInstanceTemplate() *schema.Resource {
 			},
 
 			isInstanceTemplateName: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ForceNew:     false,
-				ValidateFunc: validate.ValidateISName,
-				Description:  "Instance Template name",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      false,
+				ConflictsWith: []string{flex.NamePrefix},
+				ValidateFunc:  validate.ValidateISName,
+				Description:   "Instance Template name",
+			},
+			flex.NamePrefix: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isInstanceTemplateName},
+				ValidateFunc:  validate.ValidateISNamePrefix,
+				Description:   "Creates a unique Instance Template name beginning with the specified prefix",
 			},
 			// spot changes
 			"availability": &schema.Schema{
@@ -1496,7 +1507,7 @@ func ResourceIBMISInstanceTemplateValidator() *validate.ResourceValidator {
 
 func resourceIBMisInstanceTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	profile := d.Get(isInstanceTemplateProfile).(string)
-	name := d.Get(isInstanceTemplateName).(string)
+	name := flex.ResolveName(d, isInstanceTemplateName, flex.NamePrefix)
 	vpcID := d.Get(isInstanceTemplateVPC).(string)
 	zone := d.Get(isInstanceTemplateZone).(string)
 
@@ -3401,6 +3412,10 @@ func instanceTemplateGet(context context.Context, d *schema.ResourceData, meta i
 				err = fmt.Errorf("Error setting name: %s", err)
 				return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_template", "read", "set-name").GetDiag()
 			}
+			if err = d.Set(flex.NamePrefix, flex.NamePrefixFromName(*instanceTemplate.Name)); err != nil {
+				err = fmt.Errorf("Error setting name_prefix: %s", err)
+				return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_template", "read", "set-name_prefix").GetDiag()
+			}
 		}
 		if err = d.Set("crn", instanceTemplate.CRN); err != nil {
 			err = fmt.Errorf("Error setting crn: %s", err)
@@ -3899,6 +3914,10 @@ func instanceTemplateGet(context context.Context, d *schema.ResourceData, meta i
 				err = fmt.Errorf("Error setting name: %s", err)
 				return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_template", "read", "set-name").GetDiag()
 			}
+			if err = d.Set(flex.NamePrefix, flex.NamePrefixFromName(*instanceTemplate.Name)); err != nil {
+				err = fmt.Errorf("Error setting name_prefix: %s", err)
+				return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_instance_template", "read", "set-name_prefix").GetDiag()
+			}
 		}
 		if err = d.Set("crn", instanceTemplate.CRN); err != nil {
 			err = fmt.Errorf("Error setting crn: %s", err)
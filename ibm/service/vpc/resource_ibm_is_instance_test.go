@@ -4,19 +4,28 @@
 package vpc_test
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIBMISInstance_basic(t *testing.T) {
@@ -5201,3 +5210,117 @@ func testAccCheckIBMISInstanceThreadsPerCoreConfig(vpcname, subnetname, sshname,
 		}
 `, vpcname, subnetname, acc.ISZoneName, acc.ISCIDR, sshname, prefix, threadsPerCore)
 }
+
+func TestProbeInstanceReadyHTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthz" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	serverURL, _ := url.Parse(server.URL)
+	port, _ := strconv.Atoi(serverURL.Port())
+
+	readyCheck := &vpc.InstanceReadyCheck{
+		Type:           "http",
+		Address:        serverURL.Hostname(),
+		Port:           port,
+		Path:           "healthz",
+		ExpectedStatus: http.StatusOK,
+		Interval:       time.Second,
+	}
+	assert.Nil(t, vpc.ProbeInstanceReady(context.Background(), readyCheck))
+
+	readyCheck.Path = "/"
+	assert.NotNil(t, vpc.ProbeInstanceReady(context.Background(), readyCheck))
+}
+
+func TestProbeInstanceReadyTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	readyCheck := &vpc.InstanceReadyCheck{
+		Type:     "tcp",
+		Address:  "127.0.0.1",
+		Port:     port,
+		Interval: time.Second,
+	}
+	assert.Nil(t, vpc.ProbeInstanceReady(context.Background(), readyCheck))
+
+	listener.Close()
+	assert.NotNil(t, vpc.ProbeInstanceReady(context.Background(), readyCheck))
+}
+
+func TestCheckInstanceProfileChange(t *testing.T) {
+	instance := &vpcv1.Instance{
+		Status: core.StringPtr("running"),
+		Vcpu: &vpcv1.InstanceVcpu{
+			Architecture: core.StringPtr("amd64"),
+			Count:        core.Int64Ptr(2),
+		},
+		Memory:            core.Int64Ptr(8),
+		NetworkInterfaces: make([]vpcv1.NetworkInterfaceInstanceContextReference, 3),
+	}
+	profile := &vpcv1.InstanceProfile{
+		Name:                  core.StringPtr("bx2-4x16"),
+		Status:                core.StringPtr("current"),
+		VcpuArchitecture:      &vpcv1.InstanceProfileVcpuArchitecture{Value: core.StringPtr("amd64")},
+		VcpuCount:             &vpcv1.InstanceProfileVcpu{Value: core.Int64Ptr(4)},
+		Memory:                &vpcv1.InstanceProfileMemory{Value: core.Int64Ptr(16)},
+		Bandwidth:             &vpcv1.InstanceProfileBandwidth{Value: core.Int64Ptr(8000)},
+		NetworkInterfaceCount: &vpcv1.InstanceProfileNetworkInterfaceCount{Max: core.Int64Ptr(5)},
+	}
+	currentProfile := &vpcv1.InstanceProfile{
+		Name:   core.StringPtr("bx2-2x8"),
+		Family: core.StringPtr("balanced"),
+	}
+	profile.Family = core.StringPtr("balanced")
+	assert.Empty(t, vpc.CheckInstanceProfileChange(instance, currentProfile, profile, nil, 2000))
+
+	profile.VcpuArchitecture.Value = core.StringPtr("s390x")
+	profile.NetworkInterfaceCount = &vpcv1.InstanceProfileNetworkInterfaceCount{Max: core.Int64Ptr(2)}
+	profile.GpuCount = &vpcv1.InstanceProfileGpu{Value: core.Int64Ptr(1)}
+	profile.Disks = make([]vpcv1.InstanceProfileDisk, 1)
+	problems := vpc.CheckInstanceProfileChange(instance, currentProfile, profile, nil, 8000)
+	assert.Len(t, problems, 5)
+
+	profile.Family = core.StringPtr("gpu")
+	problems = vpc.CheckInstanceProfileChange(instance, currentProfile, profile, nil, 8000)
+	assert.Len(t, problems, 5)
+	assert.Contains(t, problems, "an instance cannot be moved into or out of the gpu family, profile bx2-2x8 is in the balanced family and profile bx2-4x16 in the gpu family")
+
+	profile = &vpcv1.InstanceProfile{
+		Name:      core.StringPtr("bx2-8x32"),
+		VcpuCount: &vpcv1.InstanceProfileVcpu{Value: core.Int64Ptr(8)},
+		Memory:    &vpcv1.InstanceProfileMemory{Value: core.Int64Ptr(32)},
+	}
+	host := &vpcv1.DedicatedHost{
+		Name:                      core.StringPtr("example-host"),
+		AvailableVcpu:             &vpcv1.Vcpu{Count: core.Int64Ptr(4)},
+		AvailableMemory:           core.Int64Ptr(64),
+		SupportedInstanceProfiles: []vpcv1.InstanceProfileReference{{Name: core.StringPtr("bx2-8x32")}},
+	}
+	assert.Equal(t, []string{
+		"the dedicated host example-host has 4 vCPUs available, profile bx2-8x32 requires 6 more",
+	}, vpc.CheckInstanceProfileChange(instance, nil, profile, host, 0))
+}
+
+func TestInstanceProfileChangeImpact(t *testing.T) {
+	instance := &vpcv1.Instance{Status: core.StringPtr("running")}
+	currentProfile := &vpcv1.InstanceProfile{Name: core.StringPtr("bx2-2x8"), Family: core.StringPtr("balanced")}
+	profile := &vpcv1.InstanceProfile{Name: core.StringPtr("bx2-4x16"), Family: core.StringPtr("balanced")}
+	impact := vpc.InstanceProfileChangeImpact(instance, currentProfile, profile, true)
+	assert.Contains(t, impact, "stopped while the profile is changed from bx2-2x8 to bx2-4x16")
+	assert.Contains(t, impact, "can only be resized while it is stopped, even within the balanced family")
+
+	profile = &vpcv1.InstanceProfile{Name: core.StringPtr("cx2-4x8"), Family: core.StringPtr("compute")}
+	assert.Contains(t, vpc.InstanceProfileChangeImpact(instance, currentProfile, profile, true), "moving from the balanced family to the compute family")
+
+	assert.Contains(t, vpc.InstanceProfileChangeImpact(instance, nil, nil, false), "threads per core")
+
+	instance.Status = core.StringPtr("stopped")
+	assert.Contains(t, vpc.InstanceProfileChangeImpact(instance, nil, nil, false), "not running")
+}
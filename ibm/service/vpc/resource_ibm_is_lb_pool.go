@@ -72,11 +72,23 @@ func ResourceIBMISLBPool() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			isLBPoolName: {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{isLBPoolName, flex.NamePrefix},
 				ValidateFunc: validate.InvokeValidator("ibm_is_lb_pool", isLBPoolName),
 				Description:  "Load Balancer Pool name",
 			},
 
+			flex.NamePrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isLBPoolName, flex.NamePrefix},
+				ValidateFunc: validate.ValidateISNamePrefix,
+				Description:  "Creates a unique Load Balancer Pool name beginning with the specified prefix",
+			},
+
 			isLBID: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -426,7 +438,7 @@ func ResourceIBMISLBPoolValidator() *validate.ResourceValidator {
 func resourceIBMISLBPoolCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] LB Pool create")
-	name := d.Get(isLBPoolName).(string)
+	name := flex.ResolveName(d, isLBPoolName, flex.NamePrefix)
 	lbID := d.Get(isLBID).(string)
 	algorithm := d.Get(isLBPoolAlgorithm).(string)
 	protocol := d.Get(isLBPoolProtocol).(string)
@@ -642,6 +654,10 @@ func lbPoolGet(context context.Context, d *schema.ResourceData, meta interface{}
 		err = fmt.Errorf("Error setting name: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_pool", "read", "set-name").GetDiag()
 	}
+	if err = d.Set(flex.NamePrefix, flex.NamePrefixFromName(flex.StringValue(loadBalancerPool.Name))); err != nil {
+		err = fmt.Errorf("Error setting name_prefix: %s", err)
+		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_pool", "read", "set-name_prefix").GetDiag()
+	}
 	if err = d.Set(isLBPool, lbPoolID); err != nil {
 		err = fmt.Errorf("Error setting pool_id: %s", err)
 		return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_lb_pool", "read", "set-pool_id").GetDiag()
@@ -64,10 +64,21 @@ func ResourceIbmIsPlacementGroup() *schema.Resource {
 			},
 			"name": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", flex.NamePrefix},
 				ValidateFunc: validate.InvokeValidator("ibm_is_placement_group", "name"),
 				Description:  "The unique user-defined name for this placement group. If unspecified, the name will be a hyphenated list of randomly-selected words.",
 			},
+			flex.NamePrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"name", flex.NamePrefix},
+				ValidateFunc: validate.ValidateISNamePrefix,
+				Description:  "Creates a unique placement group name beginning with the specified prefix.",
+			},
 			"resource_group": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -174,7 +185,7 @@ func resourceIbmIsPlacementGroupCreate(context context.Context, d *schema.Resour
 	createPlacementGroupOptions := &vpcv1.CreatePlacementGroupOptions{}
 
 	createPlacementGroupOptions.SetStrategy(d.Get("strategy").(string))
-	createPlacementGroupOptions.SetName(d.Get("name").(string))
+	createPlacementGroupOptions.SetName(flex.ResolveName(d, "name", flex.NamePrefix))
 
 	if resourceGroupIntf, ok := d.GetOk("resource_group"); ok && resourceGroupIntf.(string) != "" {
 		resourceGroup := resourceGroupIntf.(string)
@@ -253,6 +264,10 @@ func resourceIbmIsPlacementGroupRead(context context.Context, d *schema.Resource
 			err = fmt.Errorf("Error setting name: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_placement_group", "read", "set-name").GetDiag()
 		}
+		if err = d.Set(flex.NamePrefix, flex.NamePrefixFromName(*placementGroup.Name)); err != nil {
+			err = fmt.Errorf("Error setting name_prefix: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_placement_group", "read", "set-name_prefix").GetDiag()
+		}
 	}
 	if placementGroup.ResourceGroup != nil {
 		if err = d.Set("resource_group", *placementGroup.ResourceGroup.ID); err != nil {
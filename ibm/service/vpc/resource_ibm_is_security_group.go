@@ -61,11 +61,21 @@ func ResourceIBMISSecurityGroup() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 
 			isSecurityGroupName: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Security group name",
-				ValidateFunc: validate.InvokeValidator("ibm_is_security_group", isSecurityGroupName),
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{flex.NamePrefix},
+				Description:   "Security group name",
+				ValidateFunc:  validate.InvokeValidator("ibm_is_security_group", isSecurityGroupName),
+			},
+			flex.NamePrefix: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{isSecurityGroupName},
+				ValidateFunc:  validate.ValidateISNamePrefix,
+				Description:   "Creates a unique security group name beginning with the specified prefix",
 			},
 			isSecurityGroupVPC: {
 				Type:        schema.TypeString,
@@ -200,8 +210,7 @@ func resourceIBMISSecurityGroupCreate(context context.Context, d *schema.Resourc
 			ID: &rg,
 		}
 	}
-	if nm, ok := d.GetOk(isSecurityGroupName); ok {
-		name = nm.(string)
+	if name = flex.ResolveName(d, isSecurityGroupName, flex.NamePrefix); name != "" {
 		createSecurityGroupOptions.Name = &name
 	}
 	sg, _, err := sess.CreateSecurityGroup(createSecurityGroupOptions)
@@ -280,6 +289,10 @@ func resourceIBMISSecurityGroupRead(context context.Context, d *schema.ResourceD
 			err = fmt.Errorf("Error setting name: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-name").GetDiag()
 		}
+		if err = d.Set(flex.NamePrefix, flex.NamePrefixFromName(*securityGroup.Name)); err != nil {
+			err = fmt.Errorf("Error setting name_prefix: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_security_group", "read", "set-name_prefix").GetDiag()
+		}
 	}
 	if !core.IsNil(securityGroup.VPC) {
 		if err = d.Set(isSecurityGroupVPC, *securityGroup.VPC.ID); err != nil {
@@ -6,6 +6,7 @@ package vpc_test
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -53,6 +54,31 @@ func TestAccIBMISSecurityGroup_basic(t *testing.T) {
 		},
 	})
 }
+func TestAccIBMISSecurityGroup_namePrefix(t *testing.T) {
+	var securityGroup string
+
+	vpcname := fmt.Sprintf("tfsg-vpc-%d", acctest.RandIntRange(10, 100))
+	prefix := "tfsg-prefix-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISsecurityGroupNamePrefixConfig(vpcname, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISSecurityGroupExists("ibm_is_security_group.testacc_security_group", securityGroup),
+					resource.TestCheckResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "name_prefix", prefix),
+					resource.TestMatchResourceAttr(
+						"ibm_is_security_group.testacc_security_group", "name", regexp.MustCompile("^"+prefix)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMISSecurityGroup_wait(t *testing.T) {
 	var securityGroup string
 
@@ -227,3 +253,19 @@ resource "ibm_is_security_group" "testacc_security_group" {
 }`, vpcname, name)
 
 }
+
+func testAccCheckIBMISsecurityGroupNamePrefixConfig(vpcname, prefix string) string {
+	return fmt.Sprintf(`
+	resource "ibm_is_vpc" "testacc_vpc" {
+		name = "%s"
+	}
+
+	resource "ibm_is_security_group" "testacc_security_group" {
+		name_prefix = "%s"
+		vpc         = ibm_is_vpc.testacc_vpc.id
+
+		lifecycle {
+			create_before_destroy = true
+		}
+	}`, vpcname, prefix)
+}
@@ -61,11 +61,22 @@ func ResourceIBMISSSHKey() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			isKeyName: {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
+				Computed:     true,
 				ForceNew:     false,
+				ExactlyOneOf: []string{isKeyName, flex.NamePrefix},
 				ValidateFunc: validate.InvokeValidator("ibm_is_security_group", isKeyName),
 				Description:  "SSH Key name",
 			},
+			flex.NamePrefix: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{isKeyName, flex.NamePrefix},
+				ValidateFunc: validate.ValidateISNamePrefix,
+				Description:  "Creates a unique SSH key name beginning with the specified prefix",
+			},
 
 			isKeyPublicKey: {
 				Type:             schema.TypeString,
@@ -202,7 +213,7 @@ func ResourceIBMISSHKeyValidator() *validate.ResourceValidator {
 func resourceIBMISSSHKeyCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 
 	log.Printf("[DEBUG] Key create")
-	name := d.Get(isKeyName).(string)
+	name := flex.ResolveName(d, isKeyName, flex.NamePrefix)
 	publickey := d.Get(isKeyPublicKey).(string)
 
 	diag := keyCreate(context, d, meta, name, publickey)
@@ -306,6 +317,10 @@ func keyGet(context context.Context, d *schema.ResourceData, meta interface{}, i
 			err = fmt.Errorf("Error setting resource_name: %s", err)
 			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_ssh_key", "read", "set-resource_name").GetDiag()
 		}
+		if err = d.Set(flex.NamePrefix, flex.NamePrefixFromName(*key.Name)); err != nil {
+			err = fmt.Errorf("Error setting name_prefix: %s", err)
+			return flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_ssh_key", "read", "set-name_prefix").GetDiag()
+		}
 	}
 	if err = d.Set("public_key", key.PublicKey); err != nil {
 		err = fmt.Errorf("Error setting public_key: %s", err)
@@ -58,7 +58,9 @@ func ResourceIBMISSubnet() *schema.Resource {
 		UpdateContext: resourceIBMISSubnetUpdate,
 		DeleteContext: resourceIBMISSubnetDelete,
 		Exists:        resourceIBMISSubnetExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMISRegionalImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -77,6 +79,8 @@ func ResourceIBMISSubnet() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			isRegionalRegion: resourceIBMISRegionSchema(),
+
 			isSubnetIpv4CidrBlock: {
 				Type:          schema.TypeString,
 				ForceNew:      true,
@@ -337,7 +341,7 @@ func resourceIBMISSubnetCreate(context context.Context, d *schema.ResourceData,
 
 func subnetCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, vpc, zone, ipv4cidr, acl, gw, rtID, rtCrn string, ipv4addrcount64 int64) diag.Diagnostics {
 
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet", "create", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -473,7 +477,7 @@ func resourceIBMISSubnetRead(context context.Context, d *schema.ResourceData, me
 }
 
 func subnetGet(context context.Context, d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet", "read", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -492,6 +496,9 @@ func subnetGet(context context.Context, d *schema.ResourceData, meta interface{}
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+	if err = setRegionalRegion(d, meta); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting region: %s", err), "ibm_is_subnet", "read", "set-region").GetDiag()
+	}
 	if !core.IsNil(subnet.Name) {
 		if err = d.Set("name", subnet.Name); err != nil {
 			err = fmt.Errorf("Error setting name: %s", err)
@@ -652,7 +659,7 @@ func resourceIBMISSubnetUpdate(context context.Context, d *schema.ResourceData,
 }
 
 func subnetUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet", "update", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -773,7 +780,7 @@ func resourceIBMISSubnetDelete(context context.Context, d *schema.ResourceData,
 }
 
 func subnetDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet", "delete", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -908,7 +915,7 @@ func resourceIBMISSubnetExists(d *schema.ResourceData, meta interface{}) (bool,
 }
 
 func subnetExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_subnet", "exists", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -99,7 +99,9 @@ func ResourceIBMISVPC() *schema.Resource {
 		UpdateContext: resourceIBMISVPCUpdate,
 		DeleteContext: resourceIBMISVPCDelete,
 		Exists:        resourceIBMISVPCExists,
-		Importer:      &schema.ResourceImporter{},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMISRegionalImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -119,6 +121,7 @@ func ResourceIBMISVPC() *schema.Resource {
 		),
 
 		Schema: map[string]*schema.Schema{
+			isRegionalRegion: resourceIBMISRegionSchema(),
 
 			"default_address_prefixes": {
 				Type: schema.TypeMap,
@@ -746,7 +749,7 @@ func resourceIBMISVPCCreate(context context.Context, d *schema.ResourceData, met
 }
 
 func vpcCreate(context context.Context, d *schema.ResourceData, meta interface{}, name, apm, rg string, isClassic bool) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc", "create", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1002,7 +1005,7 @@ func resourceIBMISVPCRead(context context.Context, d *schema.ResourceData, meta
 }
 
 func vpcGet(context context.Context, d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc", "read", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1068,6 +1071,9 @@ func vpcGet(context context.Context, d *schema.ResourceData, meta interface{}, i
 	if err := d.Set("default_address_prefixes", defaultAddressPrefixes); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting default_address_prefixes: %s", err), "ibm_is_vpc", "read", "set-default_address_prefixes").GetDiag()
 	}
+	if err = setRegionalRegion(d, meta); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting region: %s", err), "ibm_is_vpc", "read", "set-region").GetDiag()
+	}
 	if err = d.Set(isVPCName, *vpc.Name); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting name: %s", err), "ibm_is_vpc", "read", "set-name").GetDiag()
 	}
@@ -1517,7 +1523,7 @@ func resourceIBMISVPCUpdate(context context.Context, d *schema.ResourceData, met
 }
 
 func vpcUpdate(context context.Context, d *schema.ResourceData, meta interface{}, id, name string, hasChanged bool) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc", "update", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1846,7 +1852,7 @@ func resourceIBMISVPCDelete(context context.Context, d *schema.ResourceData, met
 }
 
 func vpcDelete(context context.Context, d *schema.ResourceData, meta interface{}, id string) diag.Diagnostics {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc", "delete", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -1926,7 +1932,7 @@ func resourceIBMISVPCExists(d *schema.ResourceData, meta interface{}) (bool, err
 }
 
 func vpcExists(d *schema.ResourceData, meta interface{}, id string) (bool, error) {
-	sess, err := vpcClient(meta)
+	sess, err := vpcClientForRegion(d, meta)
 	if err != nil {
 		tfErr := flex.DiscriminatedTerraformErrorf(err, err.Error(), "ibm_is_vpc", "exists", "initialize-client")
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
@@ -7,7 +7,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/netip"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/IBM/vpc-go-sdk/vpcv1"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -47,6 +50,7 @@ const (
 	rtAccessTagType              = "access"
 	rtTags                       = "tags"
 	rtUserTagType                = "user"
+	rtRoutesCheck                = "routes_check"
 )
 
 func ResourceIBMISVPCRoutingTable() *schema.Resource {
@@ -68,6 +72,10 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 					return flex.ResourceValidateAccessTags(diff, v)
 				}),
+			customdiff.Sequence(
+				func(context context.Context, diff *schema.ResourceDiff, v interface{}) error {
+					return resourceIBMISVPCRoutingTableRoutesValidate(context, diff, v)
+				}),
 		),
 		Schema: map[string]*schema.Schema{
 			rtVpcID: {
@@ -222,6 +230,66 @@ func ResourceIBMISVPCRoutingTable() *schema.Resource {
 				Set:         flex.ResourceIBMVPCHash,
 				Description: "List of access management tags",
 			},
+
+			rtRoutes: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Description: "The user routes of this routing table. When set, the routing table owns its routes and routes that are not listed are deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						rName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rName),
+							Description:  "The user-defined name for this route, unique within the routing table.",
+						},
+						rDestination: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The destination CIDR of the route.",
+						},
+						rZone: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The zone to apply the route to.",
+						},
+						rNextHop: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "If action is deliver, the next hop that packets will be delivered to: an IP address or a VPN gateway connection ID. For other action values, 0.0.0.0.",
+						},
+						rAction: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "deliver",
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", rAction),
+							Description:  "The action to perform with a packet matching the route.",
+						},
+						"advertise": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Indicates whether this route will be advertised to the ingress sources specified by the `advertise_routes_to` routing table property.",
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table_route", "priority"),
+							Description:  "The route's priority. Smaller values have higher priority.",
+						},
+					},
+				},
+			},
+
+			rtRoutesCheck: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_is_vpc_routing_table", rtRoutesCheck),
+				Description:  "How to report duplicate destinations, shadowed routes and next hops outside the VPC address prefixes in routes: warn, error or off. The check is not run when unset.",
+			},
 		},
 	}
 }
@@ -269,6 +337,14 @@ func ResourceIBMISVPCRoutingTableValidator() *validate.ResourceValidator {
 			Required:                   false,
 			AllowedValues:              actionAllowedValues})
 
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 rtRoutesCheck,
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "warn, error, off"})
+
 	ibmISVPCRoutingTableValidator := validate.ResourceValidator{ResourceName: "ibm_is_vpc_routing_table", Schema: validateSchema}
 	return &ibmISVPCRoutingTableValidator
 }
@@ -354,6 +430,16 @@ func resourceIBMISVPCRoutingTableCreate(context context.Context, d *schema.Resou
 		}
 	}
 
+	if routes, ok := d.GetOk(rtRoutes); ok {
+		newRoutes := expandVPCRoutingTableRoutes(routes.(*schema.Set))
+		err = reconcileVPCRoutingTableRoutes(context, sess, d, vpcID, *routeTable.ID, nil, newRoutes)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("reconcileVPCRoutingTableRoutes failed: %s", err.Error()), "ibm_is_vpc_routing_table", "create")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
+
 	return resourceIBMISVPCRoutingTableRead(context, d, meta)
 }
 
@@ -495,7 +581,16 @@ func resourceIBMISVPCRoutingTableRead(context context.Context, d *schema.Resourc
 	if err = d.Set(rtAccessTags, accesstags); err != nil {
 		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting access_tags: %s", err), "ibm_is_vpc_routing_table", "read", "set-access_tags").GetDiag()
 	}
-	return nil
+	routes, err := listVPCRoutingTableRoutes(context, sess, idSet[0], idSet[1])
+	if err != nil {
+		tfErr := flex.TerraformErrorf(err, fmt.Sprintf("ListVPCRoutingTableRoutesWithContext failed: %s", err.Error()), "ibm_is_vpc_routing_table", "read")
+		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+		return tfErr.GetDiag()
+	}
+	if err = d.Set(rtRoutes, flattenVPCRoutingTableRoutes(routes)); err != nil {
+		return flex.DiscriminatedTerraformErrorf(err, fmt.Sprintf("Error setting routes: %s", err), "ibm_is_vpc_routing_table", "read", "set-routes").GetDiag()
+	}
+	return vpcRoutingTableRoutesWarnings(context, sess, d, idSet[0], expandVPCRoutingTableRoutes(d.Get(rtRoutes).(*schema.Set)))
 }
 
 func routingTableResourceGroupToMap(resourceGroupItem vpcv1.ResourceGroupReference) (resourceGroupMap map[string]interface{}) {
@@ -640,6 +735,17 @@ func resourceIBMISVPCRoutingTableUpdate(context context.Context, d *schema.Resou
 		log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
 		return tfErr.GetDiag()
 	}
+
+	if d.HasChange(rtRoutes) {
+		oldRoutes, newRoutes := d.GetChange(rtRoutes)
+		routes := expandVPCRoutingTableRoutes(newRoutes.(*schema.Set))
+		err = reconcileVPCRoutingTableRoutes(context, sess, d, idSet[0], idSet[1], expandVPCRoutingTableRoutes(oldRoutes.(*schema.Set)), routes)
+		if err != nil {
+			tfErr := flex.TerraformErrorf(err, fmt.Sprintf("reconcileVPCRoutingTableRoutes failed: %s", err.Error()), "ibm_is_vpc_routing_table", "update")
+			log.Printf("[DEBUG]\n%s", tfErr.GetDebugMessage())
+			return tfErr.GetDiag()
+		}
+	}
 	return resourceIBMISVPCRoutingTableRead(context, d, meta)
 }
 
@@ -690,3 +796,362 @@ func resourceIBMISVPCRoutingTableExists(d *schema.ResourceData, meta interface{}
 	}
 	return true, nil
 }
+
+// VPCRoutingTableRoute is a user route of a routing table as configured in
+// the routes argument.
+type VPCRoutingTableRoute struct {
+	Name        string
+	Destination string
+	Zone        string
+	NextHop     string
+	Action      string
+	Advertise   bool
+	Priority    int64
+}
+
+func expandVPCRoutingTableRoutes(routes *schema.Set) []VPCRoutingTableRoute {
+	result := make([]VPCRoutingTableRoute, 0, routes.Len())
+	for _, routeIntf := range routes.List() {
+		route := routeIntf.(map[string]interface{})
+		result = append(result, VPCRoutingTableRoute{
+			Name:        route[rName].(string),
+			Destination: route[rDestination].(string),
+			Zone:        route[rZone].(string),
+			NextHop:     route[rNextHop].(string),
+			Action:      route[rAction].(string),
+			Advertise:   route["advertise"].(bool),
+			Priority:    int64(route["priority"].(int)),
+		})
+	}
+	return result
+}
+
+// flattenVPCRoutingTableRoutes returns the routes with a user origin. Learned
+// and service routes are managed by the VPC and are not part of routes.
+func flattenVPCRoutingTableRoutes(routes []vpcv1.Route) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0)
+	for _, route := range routes {
+		if route.Origin != nil && *route.Origin != "user" {
+			continue
+		}
+		routeMap := map[string]interface{}{
+			rName:        flex.StringValue(route.Name),
+			rDestination: flex.StringValue(route.Destination),
+			rAction:      flex.StringValue(route.Action),
+			"advertise":  route.Advertise != nil && *route.Advertise,
+		}
+		if route.Zone != nil {
+			routeMap[rZone] = flex.StringValue(route.Zone.Name)
+		}
+		if route.Priority != nil {
+			routeMap["priority"] = int(*route.Priority)
+		}
+		if nextHop, ok := route.NextHop.(*vpcv1.RouteNextHop); ok {
+			if nextHop.ID != nil {
+				routeMap[rNextHop] = *nextHop.ID
+			} else if nextHop.Address != nil {
+				routeMap[rNextHop] = *nextHop.Address
+			}
+		}
+		result = append(result, routeMap)
+	}
+	return result
+}
+
+func listVPCRoutingTableRoutes(context context.Context, sess *vpcv1.VpcV1, vpcID, routingTableID string) ([]vpcv1.Route, error) {
+	start := ""
+	allrecs := []vpcv1.Route{}
+	for {
+		listVpcRoutingTableRoutesOptions := sess.NewListVPCRoutingTableRoutesOptions(vpcID, routingTableID)
+		if start != "" {
+			listVpcRoutingTableRoutesOptions.Start = &start
+		}
+		result, _, err := sess.ListVPCRoutingTableRoutesWithContext(context, listVpcRoutingTableRoutesOptions)
+		if err != nil {
+			return nil, err
+		}
+		start = flex.GetNext(result.Next)
+		allrecs = append(allrecs, result.Routes...)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+// reconcileVPCRoutingTableRoutes applies the difference between the old and
+// new routes, matched by name. Routes whose destination, zone or action changed
+// are replaced because the API does not allow these to be updated. Routes are
+// deleted first so that their names and destinations can be reused.
+func reconcileVPCRoutingTableRoutes(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID, routingTableID string, oldRoutes, newRoutes []VPCRoutingTableRoute) error {
+	existing, err := listVPCRoutingTableRoutes(context, sess, vpcID, routingTableID)
+	if err != nil {
+		return err
+	}
+	existingIDs := make(map[string]string, len(existing))
+	for _, route := range existing {
+		if route.Origin != nil && *route.Origin == "user" && route.Name != nil {
+			existingIDs[*route.Name] = *route.ID
+		}
+	}
+	oldByName := make(map[string]VPCRoutingTableRoute, len(oldRoutes))
+	for _, route := range oldRoutes {
+		oldByName[route.Name] = route
+	}
+	newByName := make(map[string]VPCRoutingTableRoute, len(newRoutes))
+	for _, route := range newRoutes {
+		newByName[route.Name] = route
+	}
+
+	for _, oldRoute := range oldRoutes {
+		newRoute, ok := newByName[oldRoute.Name]
+		if ok && newRoute.Destination == oldRoute.Destination && newRoute.Zone == oldRoute.Zone && newRoute.Action == oldRoute.Action {
+			continue
+		}
+		routeID, ok := existingIDs[oldRoute.Name]
+		if !ok {
+			continue
+		}
+		log.Printf("[INFO] Deleting route %s (%s) of routing table %s", oldRoute.Name, routeID, routingTableID)
+		response, err := sess.DeleteVPCRoutingTableRouteWithContext(context, sess.NewDeleteVPCRoutingTableRouteOptions(vpcID, routingTableID, routeID))
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("[ERROR] Error deleting route %s: %s", oldRoute.Name, err)
+		}
+		if _, err = isWaitForVPCRoutingTableRouteDeleted(context, sess, vpcID, routingTableID, routeID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+		delete(existingIDs, oldRoute.Name)
+	}
+
+	for _, newRoute := range newRoutes {
+		routeID, exists := existingIDs[newRoute.Name]
+		if !exists {
+			if err = createVPCRoutingTableRoute(context, sess, vpcID, routingTableID, newRoute); err != nil {
+				return err
+			}
+			continue
+		}
+		oldRoute, ok := oldByName[newRoute.Name]
+		if ok && oldRoute == newRoute {
+			continue
+		}
+		routePatchModel := &vpcv1.RoutePatch{
+			Advertise: core.BoolPtr(newRoute.Advertise),
+			Priority:  core.Int64Ptr(newRoute.Priority),
+		}
+		if !ok || oldRoute.NextHop != newRoute.NextHop {
+			routePatchModel.NextHop = vpcRoutingTableRouteNextHopPatch(newRoute.NextHop)
+		}
+		routePatch, err := routePatchModel.AsPatch()
+		if err != nil {
+			return err
+		}
+		log.Printf("[INFO] Updating route %s (%s) of routing table %s", newRoute.Name, routeID, routingTableID)
+		_, _, err = sess.UpdateVPCRoutingTableRouteWithContext(context, sess.NewUpdateVPCRoutingTableRouteOptions(vpcID, routingTableID, routeID, routePatch))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating route %s: %s", newRoute.Name, err)
+		}
+	}
+	return nil
+}
+
+func createVPCRoutingTableRoute(context context.Context, sess *vpcv1.VpcV1, vpcID, routingTableID string, route VPCRoutingTableRoute) error {
+	zone := &vpcv1.ZoneIdentityByName{
+		Name: core.StringPtr(route.Zone),
+	}
+	createVpcRoutingTableRouteOptions := sess.NewCreateVPCRoutingTableRouteOptions(vpcID, routingTableID, route.Destination, zone)
+	createVpcRoutingTableRouteOptions.SetName(route.Name)
+	createVpcRoutingTableRouteOptions.SetAction(route.Action)
+	createVpcRoutingTableRouteOptions.SetAdvertise(route.Advertise)
+	createVpcRoutingTableRouteOptions.SetPriority(route.Priority)
+	if _, err := netip.ParseAddr(route.NextHop); err != nil {
+		createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RouteNextHopPrototype{
+			ID: core.StringPtr(route.NextHop),
+		})
+	} else {
+		createVpcRoutingTableRouteOptions.SetNextHop(&vpcv1.RouteNextHopPrototype{
+			Address: core.StringPtr(route.NextHop),
+		})
+	}
+	log.Printf("[INFO] Creating route %s of routing table %s", route.Name, routingTableID)
+	_, _, err := sess.CreateVPCRoutingTableRouteWithContext(context, createVpcRoutingTableRouteOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error creating route %s: %s", route.Name, err)
+	}
+	return nil
+}
+
+func vpcRoutingTableRouteNextHopPatch(nextHop string) *vpcv1.RouteNextHopPatch {
+	if _, err := netip.ParseAddr(nextHop); err != nil {
+		return &vpcv1.RouteNextHopPatch{
+			ID: core.StringPtr(nextHop),
+		}
+	}
+	return &vpcv1.RouteNextHopPatch{
+		Address: core.StringPtr(nextHop),
+	}
+}
+
+func isWaitForVPCRoutingTableRouteDeleted(context context.Context, sess *vpcv1.VpcV1, vpcID, routingTableID, routeID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting", "stable", "pending", "updating"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			route, response, err := sess.GetVPCRoutingTableRouteWithContext(context, sess.NewGetVPCRoutingTableRouteOptions(vpcID, routingTableID, routeID))
+			if err != nil {
+				if response != nil && response.StatusCode == 404 {
+					return route, "deleted", nil
+				}
+				return nil, "", fmt.Errorf("[ERROR] Error getting route %s: %s", routeID, err)
+			}
+			return route, *route.LifecycleState, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	return stateConf.WaitForStateContext(context)
+}
+
+func resourceIBMISVPCRoutingTableRoutesValidate(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Get(rtRoutesCheck).(string) != "error" || !diff.NewValueKnown(rtRoutes) {
+		return nil
+	}
+	routes, ok := diff.GetOk(rtRoutes)
+	if !ok {
+		return nil
+	}
+	var addressPrefixes []string
+	if vpcID := diff.Get(rtVpcID).(string); vpcID != "" && diff.NewValueKnown(rtVpcID) {
+		sess, err := vpcClient(meta)
+		if err != nil {
+			return err
+		}
+		addressPrefixes, err = listVPCAddressPrefixCIDRs(context, sess, vpcID)
+		if err != nil {
+			log.Printf("[WARN] Error listing address prefixes of VPC %s: %s", vpcID, err)
+		}
+	}
+	warnings := CheckVPCRoutingTableRoutes(expandVPCRoutingTableRoutes(routes.(*schema.Set)), addressPrefixes)
+	if len(warnings) == 0 {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] routes of routing table failed the routes_check:\n%s", strings.Join(warnings, "\n"))
+}
+
+// vpcRoutingTableRoutesWarnings returns the routes_check findings as warnings.
+// It runs on read, so that the findings are shown by the refresh of a plan and
+// after an apply, as warnings cannot be returned from a CustomizeDiff.
+func vpcRoutingTableRoutesWarnings(context context.Context, sess *vpcv1.VpcV1, d *schema.ResourceData, vpcID string, routes []VPCRoutingTableRoute) diag.Diagnostics {
+	if d.Get(rtRoutesCheck).(string) != "warn" {
+		return nil
+	}
+	addressPrefixes, err := listVPCAddressPrefixCIDRs(context, sess, vpcID)
+	if err != nil {
+		log.Printf("[WARN] Error listing address prefixes of VPC %s: %s", vpcID, err)
+	}
+	var diags diag.Diagnostics
+	for _, warning := range CheckVPCRoutingTableRoutes(routes, addressPrefixes) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Routing table routes check",
+			Detail:   warning,
+		})
+	}
+	return diags
+}
+
+func listVPCAddressPrefixCIDRs(context context.Context, sess *vpcv1.VpcV1, vpcID string) ([]string, error) {
+	start := ""
+	cidrs := []string{}
+	for {
+		listVpcAddressPrefixesOptions := sess.NewListVPCAddressPrefixesOptions(vpcID)
+		if start != "" {
+			listVpcAddressPrefixesOptions.Start = &start
+		}
+		result, _, err := sess.ListVPCAddressPrefixesWithContext(context, listVpcAddressPrefixesOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, addressPrefix := range result.AddressPrefixes {
+			cidrs = append(cidrs, flex.StringValue(addressPrefix.CIDR))
+		}
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return cidrs, nil
+}
+
+// CheckVPCRoutingTableRoutes reports routes in the same zone with the same
+// destination, routes that are shadowed by a more specific route, and deliver
+// routes whose next hop is not within one of the addressPrefixes. The next hop
+// check is skipped when addressPrefixes is nil.
+func CheckVPCRoutingTableRoutes(routes []VPCRoutingTableRoute, addressPrefixes []string) []string {
+	warnings := []string{}
+	sorted := make([]VPCRoutingTableRoute, len(routes))
+	copy(sorted, routes)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	destinations := make([]netip.Prefix, len(sorted))
+	for i, route := range sorted {
+		destination, err := netip.ParsePrefix(route.Destination)
+		if err != nil {
+			warnings = append(warnings, fmt.Sprintf("route %q has an invalid destination %s", route.Name, route.Destination))
+			continue
+		}
+		destinations[i] = destination.Masked()
+	}
+
+	for i, route := range sorted {
+		if !destinations[i].IsValid() {
+			continue
+		}
+		for j := i + 1; j < len(sorted); j++ {
+			other := sorted[j]
+			if other.Zone != route.Zone || !destinations[j].IsValid() {
+				continue
+			}
+			switch {
+			case destinations[i] == destinations[j]:
+				warnings = append(warnings, fmt.Sprintf("routes %q and %q have the same destination %s in zone %s", route.Name, other.Name, destinations[i], route.Zone))
+			case destinations[i].Bits() < destinations[j].Bits() && destinations[i].Contains(destinations[j].Addr()):
+				warnings = append(warnings, fmt.Sprintf("route %q (%s) is shadowed for %s by the more specific route %q in zone %s", route.Name, destinations[i], destinations[j], other.Name, route.Zone))
+			case destinations[j].Bits() < destinations[i].Bits() && destinations[j].Contains(destinations[i].Addr()):
+				warnings = append(warnings, fmt.Sprintf("route %q (%s) is shadowed for %s by the more specific route %q in zone %s", other.Name, destinations[j], destinations[i], route.Name, route.Zone))
+			}
+		}
+	}
+
+	if addressPrefixes != nil {
+		prefixes := make([]netip.Prefix, 0, len(addressPrefixes))
+		for _, addressPrefix := range addressPrefixes {
+			if prefix, err := netip.ParsePrefix(addressPrefix); err == nil {
+				prefixes = append(prefixes, prefix)
+			}
+		}
+		for _, route := range sorted {
+			if route.Action != "deliver" {
+				continue
+			}
+			nextHop, err := netip.ParseAddr(route.NextHop)
+			if err != nil {
+				continue
+			}
+			inVPC := false
+			for _, prefix := range prefixes {
+				if prefix.Contains(nextHop) {
+					inVPC = true
+					break
+				}
+			}
+			if !inVPC {
+				warnings = append(warnings, fmt.Sprintf("the next hop %s of route %q is not within an address prefix of the VPC", route.NextHop, route.Name))
+			}
+		}
+	}
+	return warnings
+}
@@ -10,10 +10,12 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/vpc"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccIBMISVPCRoutingTable_basic(t *testing.T) {
@@ -47,6 +49,66 @@ func TestAccIBMISVPCRoutingTable_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMISVPCRoutingTable_routes(t *testing.T) {
+	var vpcRouteTables string
+	name1 := fmt.Sprintf("tfvpc-create-%d", acctest.RandIntRange(10, 100))
+	routeTableName := fmt.Sprintf("tfvpcrt-create-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMISVPCRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMISVPCRouteTableRoutesConfig(routeTableName, name1, "10.240.0.4", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRouteTableExists("ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", vpcRouteTables),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "routes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "routes.*", map[string]string{
+							"name":     "route-onprem",
+							"next_hop": "10.240.0.4",
+						}),
+				),
+			},
+			{
+				Config: testAccCheckIBMISVPCRouteTableRoutesConfig(routeTableName, name1, "10.240.0.5", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMISVPCRouteTableExists("ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", vpcRouteTables),
+					resource.TestCheckResourceAttr(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "routes.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_is_vpc_routing_table.test_ibm_is_vpc_routing_table", "routes.*", map[string]string{
+							"name":     "route-onprem",
+							"next_hop": "10.240.0.5",
+						}),
+				),
+			},
+		},
+	})
+}
+
+func TestCheckVPCRoutingTableRoutes(t *testing.T) {
+	routes := []vpc.VPCRoutingTableRoute{
+		{Name: "route-a", Destination: "192.168.0.0/16", Zone: "us-south-1", NextHop: "10.240.0.4", Action: "deliver"},
+		{Name: "route-b", Destination: "192.168.10.0/24", Zone: "us-south-1", NextHop: "10.240.0.5", Action: "deliver"},
+		{Name: "route-c", Destination: "192.168.10.0/24", Zone: "us-south-1", NextHop: "10.10.0.5", Action: "deliver"},
+		{Name: "route-d", Destination: "192.168.10.0/24", Zone: "us-south-2", NextHop: "0.0.0.0", Action: "drop"},
+	}
+
+	warnings := vpc.CheckVPCRoutingTableRoutes(routes, []string{"10.240.0.0/18"})
+	assert.Equal(t, []string{
+		`route "route-a" (192.168.0.0/16) is shadowed for 192.168.10.0/24 by the more specific route "route-b" in zone us-south-1`,
+		`route "route-a" (192.168.0.0/16) is shadowed for 192.168.10.0/24 by the more specific route "route-c" in zone us-south-1`,
+		`routes "route-b" and "route-c" have the same destination 192.168.10.0/24 in zone us-south-1`,
+		`the next hop 10.10.0.5 of route "route-c" is not within an address prefix of the VPC`,
+	}, warnings)
+
+	warnings = vpc.CheckVPCRoutingTableRoutes(routes[3:], nil)
+	assert.Empty(t, warnings)
+}
+
 func TestAccIBMISVPCRoutingTable_acceptRoutesFrom(t *testing.T) {
 	var vpcRouteTables string
 	name1 := fmt.Sprintf("tfvpc-create-%d", acctest.RandIntRange(10, 100))
@@ -271,3 +333,31 @@ resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
 	advertise_routes_to=[]
 }`, name, rtName)
 }
+
+func testAccCheckIBMISVPCRouteTableRoutesConfig(rtName, name, nextHop string, withDropRoute bool) string {
+	dropRoute := ""
+	if withDropRoute {
+		dropRoute = fmt.Sprintf(`
+	routes {
+		name        = "route-drop"
+		destination = "192.168.20.0/24"
+		zone        = "%s"
+		action      = "drop"
+		next_hop    = "0.0.0.0"
+	}`, acc.ISZoneName)
+	}
+	return fmt.Sprintf(`
+resource "ibm_is_vpc" "testacc_vpc" {
+	name = "%s"
+}
+resource "ibm_is_vpc_routing_table" "test_ibm_is_vpc_routing_table" {
+	vpc  = ibm_is_vpc.testacc_vpc.id
+	name = "%s"
+	routes {
+		name        = "route-onprem"
+		destination = "192.168.10.0/24"
+		zone        = "%s"
+		next_hop    = "%s"
+	}%s
+}`, name, rtName, acc.ISZoneName, nextHop, dropRoute)
+}
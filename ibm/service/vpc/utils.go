@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2026 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package vpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	isRegionalRegion = "region"
+)
+
+// resourceIBMISRegionSchema is the optional region override of a regional
+// VPC resource. It defaults to the provider region.
+func resourceIBMISRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		ForceNew:    true,
+		Description: "The region in which the resource is managed. Defaults to the provider region.",
+	}
+}
+
+// dataSourceIBMISRegionSchema is the optional region override of a regional
+// VPC data source. It defaults to the provider region.
+func dataSourceIBMISRegionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Computed:    true,
+		Description: "The region in which to look up the resource. Defaults to the provider region.",
+	}
+}
+
+// vpcClientForRegion returns the VPC client for the region selected by the
+// region argument of d, or the provider client when none is selected.
+func vpcClientForRegion(d *schema.ResourceData, meta interface{}) (*vpcv1.VpcV1, error) {
+	return meta.(conns.ClientSession).VpcV1APIForRegion(d.Get(isRegionalRegion).(string))
+}
+
+// setRegionalRegion records the region a resource is managed in, using the
+// provider region when the configuration does not select one.
+func setRegionalRegion(d *schema.ResourceData, meta interface{}) error {
+	if d.Get(isRegionalRegion).(string) != "" {
+		return nil
+	}
+	bmxSess, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	return d.Set(isRegionalRegion, bmxSess.Config.Region)
+}
+
+// resourceIBMISRegionalImport accepts either an ID or <region>/<id>, the latter
+// importing a resource managed outside the provider region.
+func resourceIBMISRegionalImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if region, id, found := strings.Cut(d.Id(), "/"); found {
+		if err := d.Set(isRegionalRegion, region); err != nil {
+			return nil, err
+		}
+		d.SetId(id)
+	}
+	return []*schema.ResourceData{d}, nil
+}
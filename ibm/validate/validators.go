@@ -1094,6 +1094,24 @@ func ValidateISName(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidateISNamePrefix validates a name prefix so that the names generated from
+// it by flex.PrefixedUniqueName are accepted by ValidateISName
+func ValidateISNamePrefix(v interface{}, k string) (ws []string, errors []error) {
+	prefix := v.(string)
+	maxLength := 63 - flex.NamePrefixSuffixLength
+	if acceptedcharacters, _ := regexp.MatchString(`^[a-z][-a-z0-9]*$`, prefix); !acceptedcharacters {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) should contain only lowercase alphanumeric,dash and should begin with lowercase character", k, v))
+	} else if len(prefix) > maxLength {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) should not exceed %d characters", k, v, maxLength))
+	} else if strings.Contains(prefix, "--") {
+		errors = append(errors, fmt.Errorf(
+			"%q (%q) should not contain consecutive dash(-)", k, v))
+	}
+	return
+}
+
 // ValidateFunc is honored only when the schema's Type is set to TypeInt,
 // TypeFloat, TypeString, TypeBool, or TypeMap. It is ignored for all other types.
 // enum to list all the validator functions supported by this tool.